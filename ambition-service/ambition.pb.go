@@ -11,11 +11,31 @@ It is generated from these files:
 It has these top-level messages:
 	OccurrencesByDateReq
 	Action
+	Reminder
 	CreateOccurrenceRequest
+	Location
 	Occurrence
 	User
+	ReadActionsRequest
 	ActionsResponse
 	OccurrencesResponse
+	CloneActionsRequest
+	ReconcileOccurrencesRequest
+	ReconcileOccurrencesResponse
+	DaySummaryRequest
+	DaySummaryResponse
+	ActionDaySummary
+	ShiftOccurrencesRequest
+	ShiftOccurrencesResponse
+	TransferActionRequest
+	DueRemindersRequest
+	OccurrencesMultiRequest
+	OccurrencesMultiResponse
+	ActionOccurrences
+	CompactOccurrencesRequest
+	CompactOccurrencesResponse
+	ReadUnloggedActionsRequest
+	UserUsage
 */
 package ambition
 
@@ -78,6 +98,17 @@ type Action struct {
 	// TODO: Think about moving this to ambition-users
 	// with a UserAction table
 	UserID int64 `protobuf:"varint,3,opt,name=UserID" json:"UserID,omitempty"`
+	// string TrelloID= 4;
+	// LastTouched is when TouchAction was last called in unix nanoseconds
+	LastTouched int64 `protobuf:"varint,5,opt,name=LastTouched" json:"LastTouched,omitempty"`
+	// Monotonic actions only accept occurrences later than their latest one
+	Monotonic bool `protobuf:"varint,6,opt,name=Monotonic" json:"Monotonic,omitempty"`
+	// Description is markdown explaining the action. Raw HTML outside of
+	// code is removed when it is stored and descriptions with unsafe links
+	// are rejected
+	Description string `protobuf:"bytes,7,opt,name=Description" json:"Description,omitempty"`
+	// Reminder is when clients should remind the user about the action
+	Reminder *Reminder `protobuf:"bytes,8,opt,name=Reminder" json:"Reminder,omitempty"`
 }
 
 func (m *Action) Reset()                    { *m = Action{} }
@@ -106,15 +137,87 @@ func (m *Action) GetUserID() int64 {
 	return 0
 }
 
+func (m *Action) GetLastTouched() int64 {
+	if m != nil {
+		return m.LastTouched
+	}
+	return 0
+}
+
+func (m *Action) GetMonotonic() bool {
+	if m != nil {
+		return m.Monotonic
+	}
+	return false
+}
+
+func (m *Action) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Action) GetReminder() *Reminder {
+	if m != nil {
+		return m.Reminder
+	}
+	return nil
+}
+
+type Reminder struct {
+	// Times are times of day as HH:MM, a reminder without times is removed
+	// At most 48 times are allowed, duplicate times and weekdays are removed
+	Times []string `protobuf:"bytes,1,rep,name=Times" json:"Times,omitempty"`
+	// Weekdays are days of the week from 0 for Sunday to 6 for Saturday,
+	// empty means every day
+	Weekdays []int64 `protobuf:"varint,2,rep,packed,name=Weekdays" json:"Weekdays,omitempty"`
+	// Timezone is the IANA timezone Times are in, such as
+	// America/Los_Angeles
+	Timezone string `protobuf:"bytes,3,opt,name=Timezone" json:"Timezone,omitempty"`
+}
+
+func (m *Reminder) Reset()                    { *m = Reminder{} }
+func (m *Reminder) String() string            { return proto.CompactTextString(m) }
+func (*Reminder) ProtoMessage()               {}
+func (*Reminder) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Reminder) GetTimes() []string {
+	if m != nil {
+		return m.Times
+	}
+	return nil
+}
+
+func (m *Reminder) GetWeekdays() []int64 {
+	if m != nil {
+		return m.Weekdays
+	}
+	return nil
+}
+
+func (m *Reminder) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
 type CreateOccurrenceRequest struct {
 	UserID     int64       `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
 	Occurrence *Occurrence `protobuf:"bytes,2,opt,name=Occurrence" json:"Occurrence,omitempty"`
+	// Location is where the occurrence happened, it is never stored
+	// Leave Location unset when it is not known, 0, 0 is a real place
+	Location *Location `protobuf:"bytes,3,opt,name=Location" json:"Location,omitempty"`
+	// ActionName is used to find the action when Occurrence.ActionID is 0
+	ActionName       string `protobuf:"bytes,4,opt,name=ActionName" json:"ActionName,omitempty"`
+	AutoCreateAction bool   `protobuf:"varint,5,opt,name=AutoCreateAction" json:"AutoCreateAction,omitempty"`
 }
 
 func (m *CreateOccurrenceRequest) Reset()                    { *m = CreateOccurrenceRequest{} }
 func (m *CreateOccurrenceRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateOccurrenceRequest) ProtoMessage()               {}
-func (*CreateOccurrenceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*CreateOccurrenceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *CreateOccurrenceRequest) GetUserID() int64 {
 	if m != nil {
@@ -130,17 +233,66 @@ func (m *CreateOccurrenceRequest) GetOccurrence() *Occurrence {
 	return nil
 }
 
+func (m *CreateOccurrenceRequest) GetLocation() *Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+func (m *CreateOccurrenceRequest) GetActionName() string {
+	if m != nil {
+		return m.ActionName
+	}
+	return ""
+}
+
+func (m *CreateOccurrenceRequest) GetAutoCreateAction() bool {
+	if m != nil {
+		return m.AutoCreateAction
+	}
+	return false
+}
+
+type Location struct {
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=Latitude" json:"Latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=Longitude" json:"Longitude,omitempty"`
+}
+
+func (m *Location) Reset()                    { *m = Location{} }
+func (m *Location) String() string            { return proto.CompactTextString(m) }
+func (*Location) ProtoMessage()               {}
+func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Location) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *Location) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
 type Occurrence struct {
 	ID       int64  `protobuf:"varint,1,opt,name=ID" json:"ID,omitempty"`
 	ActionID int64  `protobuf:"varint,2,opt,name=ActionID" json:"ActionID,omitempty"`
 	Datetime string `protobuf:"bytes,3,opt,name=Datetime" json:"Datetime,omitempty"`
 	Data     string `protobuf:"bytes,4,opt,name=Data" json:"Data,omitempty"`
+	// Rating is how the occurrence felt from 1 to 5, zero means no rating
+	Rating int64 `protobuf:"varint,5,opt,name=Rating" json:"Rating,omitempty"`
+	// Geohash is the coarse area the occurrence happened in, if known
+	Geohash string `protobuf:"bytes,6,opt,name=Geohash" json:"Geohash,omitempty"`
 }
 
 func (m *Occurrence) Reset()                    { *m = Occurrence{} }
 func (m *Occurrence) String() string            { return proto.CompactTextString(m) }
 func (*Occurrence) ProtoMessage()               {}
-func (*Occurrence) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*Occurrence) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *Occurrence) GetID() int64 {
 	if m != nil {
@@ -170,6 +322,20 @@ func (m *Occurrence) GetData() string {
 	return ""
 }
 
+func (m *Occurrence) GetRating() int64 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *Occurrence) GetGeohash() string {
+	if m != nil {
+		return m.Geohash
+	}
+	return ""
+}
+
 type User struct {
 	UserID int64 `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
 }
@@ -177,7 +343,7 @@ type User struct {
 func (m *User) Reset()                    { *m = User{} }
 func (m *User) String() string            { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()               {}
-func (*User) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*User) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 func (m *User) GetUserID() int64 {
 	if m != nil {
@@ -186,6 +352,40 @@ func (m *User) GetUserID() int64 {
 	return 0
 }
 
+type ReadActionsRequest struct {
+	UserID int64 `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	// MinOccurrences filters the actions, zero means no filter
+	MinOccurrences int64 `protobuf:"varint,2,opt,name=MinOccurrences" json:"MinOccurrences,omitempty"`
+	// OrderBy orders the actions, "recency" or empty for no order
+	OrderBy string `protobuf:"bytes,3,opt,name=OrderBy" json:"OrderBy,omitempty"`
+}
+
+func (m *ReadActionsRequest) Reset()                    { *m = ReadActionsRequest{} }
+func (m *ReadActionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadActionsRequest) ProtoMessage()               {}
+func (*ReadActionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *ReadActionsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *ReadActionsRequest) GetMinOccurrences() int64 {
+	if m != nil {
+		return m.MinOccurrences
+	}
+	return 0
+}
+
+func (m *ReadActionsRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
 type ActionsResponse struct {
 	Actions []*Action `protobuf:"bytes,1,rep,name=Actions" json:"Actions,omitempty"`
 }
@@ -193,7 +393,7 @@ type ActionsResponse struct {
 func (m *ActionsResponse) Reset()                    { *m = ActionsResponse{} }
 func (m *ActionsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ActionsResponse) ProtoMessage()               {}
-func (*ActionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*ActionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 func (m *ActionsResponse) GetActions() []*Action {
 	if m != nil {
@@ -209,7 +409,7 @@ type OccurrencesResponse struct {
 func (m *OccurrencesResponse) Reset()                    { *m = OccurrencesResponse{} }
 func (m *OccurrencesResponse) String() string            { return proto.CompactTextString(m) }
 func (*OccurrencesResponse) ProtoMessage()               {}
-func (*OccurrencesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*OccurrencesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *OccurrencesResponse) GetOccurrences() []*Occurrence {
 	if m != nil {
@@ -218,101 +418,795 @@ func (m *OccurrencesResponse) GetOccurrences() []*Occurrence {
 	return nil
 }
 
+type CloneActionsRequest struct {
+	UserID       int64 `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	SourceUserID int64 `protobuf:"varint,2,opt,name=SourceUserID" json:"SourceUserID,omitempty"`
+}
+
+func (m *CloneActionsRequest) Reset()                    { *m = CloneActionsRequest{} }
+func (m *CloneActionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*CloneActionsRequest) ProtoMessage()               {}
+func (*CloneActionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *CloneActionsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *CloneActionsRequest) GetSourceUserID() int64 {
+	if m != nil {
+		return m.SourceUserID
+	}
+	return 0
+}
+
+type ReconcileOccurrencesRequest struct {
+	UserID        int64   `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	ActionID      int64   `protobuf:"varint,2,opt,name=ActionID" json:"ActionID,omitempty"`
+	OccurrenceIDs []int64 `protobuf:"varint,3,rep,packed,name=OccurrenceIDs" json:"OccurrenceIDs,omitempty"`
+}
+
+func (m *ReconcileOccurrencesRequest) Reset()                    { *m = ReconcileOccurrencesRequest{} }
+func (m *ReconcileOccurrencesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReconcileOccurrencesRequest) ProtoMessage()               {}
+func (*ReconcileOccurrencesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *ReconcileOccurrencesRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *ReconcileOccurrencesRequest) GetActionID() int64 {
+	if m != nil {
+		return m.ActionID
+	}
+	return 0
+}
+
+func (m *ReconcileOccurrencesRequest) GetOccurrenceIDs() []int64 {
+	if m != nil {
+		return m.OccurrenceIDs
+	}
+	return nil
+}
+
+type ReconcileOccurrencesResponse struct {
+	ServerOnly []int64 `protobuf:"varint,1,rep,packed,name=ServerOnly" json:"ServerOnly,omitempty"`
+	ClientOnly []int64 `protobuf:"varint,2,rep,packed,name=ClientOnly" json:"ClientOnly,omitempty"`
+}
+
+func (m *ReconcileOccurrencesResponse) Reset()                    { *m = ReconcileOccurrencesResponse{} }
+func (m *ReconcileOccurrencesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReconcileOccurrencesResponse) ProtoMessage()               {}
+func (*ReconcileOccurrencesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ReconcileOccurrencesResponse) GetServerOnly() []int64 {
+	if m != nil {
+		return m.ServerOnly
+	}
+	return nil
+}
+
+func (m *ReconcileOccurrencesResponse) GetClientOnly() []int64 {
+	if m != nil {
+		return m.ClientOnly
+	}
+	return nil
+}
+
+type DaySummaryRequest struct {
+	UserID   int64  `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	Date     string `protobuf:"bytes,2,opt,name=Date" json:"Date,omitempty"`
+	Timezone string `protobuf:"bytes,3,opt,name=Timezone" json:"Timezone,omitempty"`
+}
+
+func (m *DaySummaryRequest) Reset()                    { *m = DaySummaryRequest{} }
+func (m *DaySummaryRequest) String() string            { return proto.CompactTextString(m) }
+func (*DaySummaryRequest) ProtoMessage()               {}
+func (*DaySummaryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *DaySummaryRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *DaySummaryRequest) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *DaySummaryRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+type DaySummaryResponse struct {
+	Actions []*ActionDaySummary `protobuf:"bytes,1,rep,name=Actions" json:"Actions,omitempty"`
+}
+
+func (m *DaySummaryResponse) Reset()                    { *m = DaySummaryResponse{} }
+func (m *DaySummaryResponse) String() string            { return proto.CompactTextString(m) }
+func (*DaySummaryResponse) ProtoMessage()               {}
+func (*DaySummaryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *DaySummaryResponse) GetActions() []*ActionDaySummary {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type ActionDaySummary struct {
+	Action      *Action       `protobuf:"bytes,1,opt,name=Action" json:"Action,omitempty"`
+	Count       int64         `protobuf:"varint,2,opt,name=Count" json:"Count,omitempty"`
+	Occurrences []*Occurrence `protobuf:"bytes,3,rep,name=Occurrences" json:"Occurrences,omitempty"`
+	// AverageRating is the mean Rating of the rated Occurrences, zero if none
+	// are rated
+	AverageRating float64 `protobuf:"fixed64,4,opt,name=AverageRating" json:"AverageRating,omitempty"`
+}
+
+func (m *ActionDaySummary) Reset()                    { *m = ActionDaySummary{} }
+func (m *ActionDaySummary) String() string            { return proto.CompactTextString(m) }
+func (*ActionDaySummary) ProtoMessage()               {}
+func (*ActionDaySummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *ActionDaySummary) GetAction() *Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *ActionDaySummary) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ActionDaySummary) GetOccurrences() []*Occurrence {
+	if m != nil {
+		return m.Occurrences
+	}
+	return nil
+}
+
+func (m *ActionDaySummary) GetAverageRating() float64 {
+	if m != nil {
+		return m.AverageRating
+	}
+	return 0
+}
+
+type ShiftOccurrencesRequest struct {
+	UserID   int64 `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	ActionID int64 `protobuf:"varint,2,opt,name=ActionID" json:"ActionID,omitempty"`
+	// Offset is a signed duration such as "90m" or "-7h"
+	Offset  string `protobuf:"bytes,3,opt,name=Offset" json:"Offset,omitempty"`
+	Confirm bool   `protobuf:"varint,4,opt,name=Confirm" json:"Confirm,omitempty"`
+}
+
+func (m *ShiftOccurrencesRequest) Reset()                    { *m = ShiftOccurrencesRequest{} }
+func (m *ShiftOccurrencesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ShiftOccurrencesRequest) ProtoMessage()               {}
+func (*ShiftOccurrencesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *ShiftOccurrencesRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *ShiftOccurrencesRequest) GetActionID() int64 {
+	if m != nil {
+		return m.ActionID
+	}
+	return 0
+}
+
+func (m *ShiftOccurrencesRequest) GetOffset() string {
+	if m != nil {
+		return m.Offset
+	}
+	return ""
+}
+
+func (m *ShiftOccurrencesRequest) GetConfirm() bool {
+	if m != nil {
+		return m.Confirm
+	}
+	return false
+}
+
+type ShiftOccurrencesResponse struct {
+	Shifted int64 `protobuf:"varint,1,opt,name=Shifted" json:"Shifted,omitempty"`
+}
+
+func (m *ShiftOccurrencesResponse) Reset()                    { *m = ShiftOccurrencesResponse{} }
+func (m *ShiftOccurrencesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ShiftOccurrencesResponse) ProtoMessage()               {}
+func (*ShiftOccurrencesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *ShiftOccurrencesResponse) GetShifted() int64 {
+	if m != nil {
+		return m.Shifted
+	}
+	return 0
+}
+
+type TransferActionRequest struct {
+	UserID    int64 `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	ActionID  int64 `protobuf:"varint,2,opt,name=ActionID" json:"ActionID,omitempty"`
+	NewUserID int64 `protobuf:"varint,3,opt,name=NewUserID" json:"NewUserID,omitempty"`
+}
+
+func (m *TransferActionRequest) Reset()                    { *m = TransferActionRequest{} }
+func (m *TransferActionRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransferActionRequest) ProtoMessage()               {}
+func (*TransferActionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *TransferActionRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *TransferActionRequest) GetActionID() int64 {
+	if m != nil {
+		return m.ActionID
+	}
+	return 0
+}
+
+func (m *TransferActionRequest) GetNewUserID() int64 {
+	if m != nil {
+		return m.NewUserID
+	}
+	return 0
+}
+
+type DueRemindersRequest struct {
+	UserID int64  `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	Time   string `protobuf:"bytes,2,opt,name=Time" json:"Time,omitempty"`
+}
+
+func (m *DueRemindersRequest) Reset()                    { *m = DueRemindersRequest{} }
+func (m *DueRemindersRequest) String() string            { return proto.CompactTextString(m) }
+func (*DueRemindersRequest) ProtoMessage()               {}
+func (*DueRemindersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *DueRemindersRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *DueRemindersRequest) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+type OccurrencesMultiRequest struct {
+	UserID    int64   `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	ActionIDs []int64 `protobuf:"varint,2,rep,packed,name=ActionIDs" json:"ActionIDs,omitempty"`
+	Start     string  `protobuf:"bytes,3,opt,name=Start" json:"Start,omitempty"`
+	End       string  `protobuf:"bytes,4,opt,name=End" json:"End,omitempty"`
+}
+
+func (m *OccurrencesMultiRequest) Reset()                    { *m = OccurrencesMultiRequest{} }
+func (m *OccurrencesMultiRequest) String() string            { return proto.CompactTextString(m) }
+func (*OccurrencesMultiRequest) ProtoMessage()               {}
+func (*OccurrencesMultiRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *OccurrencesMultiRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *OccurrencesMultiRequest) GetActionIDs() []int64 {
+	if m != nil {
+		return m.ActionIDs
+	}
+	return nil
+}
+
+func (m *OccurrencesMultiRequest) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *OccurrencesMultiRequest) GetEnd() string {
+	if m != nil {
+		return m.End
+	}
+	return ""
+}
+
+type OccurrencesMultiResponse struct {
+	Actions []*ActionOccurrences `protobuf:"bytes,1,rep,name=Actions" json:"Actions,omitempty"`
+}
+
+func (m *OccurrencesMultiResponse) Reset()                    { *m = OccurrencesMultiResponse{} }
+func (m *OccurrencesMultiResponse) String() string            { return proto.CompactTextString(m) }
+func (*OccurrencesMultiResponse) ProtoMessage()               {}
+func (*OccurrencesMultiResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *OccurrencesMultiResponse) GetActions() []*ActionOccurrences {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+type ActionOccurrences struct {
+	ActionID    int64         `protobuf:"varint,1,opt,name=ActionID" json:"ActionID,omitempty"`
+	Occurrences []*Occurrence `protobuf:"bytes,2,rep,name=Occurrences" json:"Occurrences,omitempty"`
+	// AverageRating is the mean Rating of the rated Occurrences, zero if none
+	// are rated
+	AverageRating float64 `protobuf:"fixed64,3,opt,name=AverageRating" json:"AverageRating,omitempty"`
+}
+
+func (m *ActionOccurrences) Reset()                    { *m = ActionOccurrences{} }
+func (m *ActionOccurrences) String() string            { return proto.CompactTextString(m) }
+func (*ActionOccurrences) ProtoMessage()               {}
+func (*ActionOccurrences) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *ActionOccurrences) GetActionID() int64 {
+	if m != nil {
+		return m.ActionID
+	}
+	return 0
+}
+
+func (m *ActionOccurrences) GetOccurrences() []*Occurrence {
+	if m != nil {
+		return m.Occurrences
+	}
+	return nil
+}
+
+func (m *ActionOccurrences) GetAverageRating() float64 {
+	if m != nil {
+		return m.AverageRating
+	}
+	return 0
+}
+
+type CompactOccurrencesRequest struct {
+	UserID   int64  `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	ActionID int64  `protobuf:"varint,2,opt,name=ActionID" json:"ActionID,omitempty"`
+	Timezone string `protobuf:"bytes,3,opt,name=Timezone" json:"Timezone,omitempty"`
+	DryRun   bool   `protobuf:"varint,4,opt,name=DryRun" json:"DryRun,omitempty"`
+}
+
+func (m *CompactOccurrencesRequest) Reset()                    { *m = CompactOccurrencesRequest{} }
+func (m *CompactOccurrencesRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompactOccurrencesRequest) ProtoMessage()               {}
+func (*CompactOccurrencesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *CompactOccurrencesRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *CompactOccurrencesRequest) GetActionID() int64 {
+	if m != nil {
+		return m.ActionID
+	}
+	return 0
+}
+
+func (m *CompactOccurrencesRequest) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+func (m *CompactOccurrencesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type CompactOccurrencesResponse struct {
+	Merged int64 `protobuf:"varint,1,opt,name=Merged" json:"Merged,omitempty"`
+	// Skipped is the number of days left as they are because their
+	// occurrences have different Ratings or too much Data to merge
+	Skipped int64 `protobuf:"varint,2,opt,name=Skipped" json:"Skipped,omitempty"`
+}
+
+func (m *CompactOccurrencesResponse) Reset()                    { *m = CompactOccurrencesResponse{} }
+func (m *CompactOccurrencesResponse) String() string            { return proto.CompactTextString(m) }
+func (*CompactOccurrencesResponse) ProtoMessage()               {}
+func (*CompactOccurrencesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+func (m *CompactOccurrencesResponse) GetMerged() int64 {
+	if m != nil {
+		return m.Merged
+	}
+	return 0
+}
+
+func (m *CompactOccurrencesResponse) GetSkipped() int64 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
+type ReadUnloggedActionsRequest struct {
+	UserID int64 `protobuf:"varint,1,opt,name=UserID" json:"UserID,omitempty"`
+	// MinAgeDays filters the actions, zero means no filter
+	MinAgeDays int64 `protobuf:"varint,2,opt,name=MinAgeDays" json:"MinAgeDays,omitempty"`
+}
+
+func (m *ReadUnloggedActionsRequest) Reset()                    { *m = ReadUnloggedActionsRequest{} }
+func (m *ReadUnloggedActionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReadUnloggedActionsRequest) ProtoMessage()               {}
+func (*ReadUnloggedActionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *ReadUnloggedActionsRequest) GetUserID() int64 {
+	if m != nil {
+		return m.UserID
+	}
+	return 0
+}
+
+func (m *ReadUnloggedActionsRequest) GetMinAgeDays() int64 {
+	if m != nil {
+		return m.MinAgeDays
+	}
+	return 0
+}
+
+type UserUsage struct {
+	Actions     int64 `protobuf:"varint,1,opt,name=Actions" json:"Actions,omitempty"`
+	Occurrences int64 `protobuf:"varint,2,opt,name=Occurrences" json:"Occurrences,omitempty"`
+	DataBytes   int64 `protobuf:"varint,3,opt,name=DataBytes" json:"DataBytes,omitempty"`
+}
+
+func (m *UserUsage) Reset()                    { *m = UserUsage{} }
+func (m *UserUsage) String() string            { return proto.CompactTextString(m) }
+func (*UserUsage) ProtoMessage()               {}
+func (*UserUsage) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *UserUsage) GetActions() int64 {
+	if m != nil {
+		return m.Actions
+	}
+	return 0
+}
+
+func (m *UserUsage) GetOccurrences() int64 {
+	if m != nil {
+		return m.Occurrences
+	}
+	return 0
+}
+
+func (m *UserUsage) GetDataBytes() int64 {
+	if m != nil {
+		return m.DataBytes
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OccurrencesByDateReq)(nil), "ambition.OccurrencesByDateReq")
 	proto.RegisterType((*Action)(nil), "ambition.Action")
+	proto.RegisterType((*Reminder)(nil), "ambition.Reminder")
 	proto.RegisterType((*CreateOccurrenceRequest)(nil), "ambition.CreateOccurrenceRequest")
+	proto.RegisterType((*Location)(nil), "ambition.Location")
 	proto.RegisterType((*Occurrence)(nil), "ambition.Occurrence")
 	proto.RegisterType((*User)(nil), "ambition.User")
+	proto.RegisterType((*ReadActionsRequest)(nil), "ambition.ReadActionsRequest")
 	proto.RegisterType((*ActionsResponse)(nil), "ambition.ActionsResponse")
 	proto.RegisterType((*OccurrencesResponse)(nil), "ambition.OccurrencesResponse")
+	proto.RegisterType((*CloneActionsRequest)(nil), "ambition.CloneActionsRequest")
+	proto.RegisterType((*ReconcileOccurrencesRequest)(nil), "ambition.ReconcileOccurrencesRequest")
+	proto.RegisterType((*ReconcileOccurrencesResponse)(nil), "ambition.ReconcileOccurrencesResponse")
+	proto.RegisterType((*DaySummaryRequest)(nil), "ambition.DaySummaryRequest")
+	proto.RegisterType((*DaySummaryResponse)(nil), "ambition.DaySummaryResponse")
+	proto.RegisterType((*ActionDaySummary)(nil), "ambition.ActionDaySummary")
+	proto.RegisterType((*ShiftOccurrencesRequest)(nil), "ambition.ShiftOccurrencesRequest")
+	proto.RegisterType((*ShiftOccurrencesResponse)(nil), "ambition.ShiftOccurrencesResponse")
+	proto.RegisterType((*TransferActionRequest)(nil), "ambition.TransferActionRequest")
+	proto.RegisterType((*DueRemindersRequest)(nil), "ambition.DueRemindersRequest")
+	proto.RegisterType((*OccurrencesMultiRequest)(nil), "ambition.OccurrencesMultiRequest")
+	proto.RegisterType((*OccurrencesMultiResponse)(nil), "ambition.OccurrencesMultiResponse")
+	proto.RegisterType((*ActionOccurrences)(nil), "ambition.ActionOccurrences")
+	proto.RegisterType((*CompactOccurrencesRequest)(nil), "ambition.CompactOccurrencesRequest")
+	proto.RegisterType((*CompactOccurrencesResponse)(nil), "ambition.CompactOccurrencesResponse")
+	proto.RegisterType((*ReadUnloggedActionsRequest)(nil), "ambition.ReadUnloggedActionsRequest")
+	proto.RegisterType((*UserUsage)(nil), "ambition.UserUsage")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Ambition service
+
+type AmbitionClient interface {
+	// CreateAction requires a UserID and a Name
+	CreateAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error)
+	// CreateOccurrence requires a UserID and either Occurrence.ActionID or
+	// ActionName. With AutoCreateAction an action named ActionName is created
+	// for the user, together with the occurrence, if the user has none
+	// If Datetime is provided it will be used, otherwise the current time is
+	// used. See TIMESTAMP_PARSING in the README for accepted formats
+	// If Location is provided only its geohash is stored, as
+	// Occurrence.Geohash, at the precision set by GEOHASH_PRECISION
+	// If the action is Monotonic the Datetime must be later than that of
+	// every existing occurrence of the action
+	// TODO: If Data is provided it will be stored
+	CreateOccurrence(ctx context.Context, in *CreateOccurrenceRequest, opts ...grpc.CallOption) (*Occurrence, error)
+	// ReadAction requires either an ID, or BOTH a UserId and Name
+	ReadAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error)
+	// ReadActions requires a UserID and returns all actions for that user
+	// If MinOccurrences is set only actions with at least that many
+	// occurrences are returned
+	// If OrderBy is "recency" the most recently touched actions are first
+	ReadActions(ctx context.Context, in *ReadActionsRequest, opts ...grpc.CallOption) (*ActionsResponse, error)
+	ReadOccurrencesByDate(ctx context.Context, in *OccurrencesByDateReq, opts ...grpc.CallOption) (*OccurrencesResponse, error)
+	// ReadOccurrences takes an action which must be populated with a
+	// UserID and an ActionID which must match the values for that action
+	// TODO:
+	ReadOccurrences(ctx context.Context, in *Action, opts ...grpc.CallOption) (*OccurrencesResponse, error)
+	// ReconcileOccurrences requires a UserID and an ActionID owned by that
+	// user. It compares the OccurrenceIDs a client has for the action
+	// against those stored and returns the IDs only each side has
+	ReconcileOccurrences(ctx context.Context, in *ReconcileOccurrencesRequest, opts ...grpc.CallOption) (*ReconcileOccurrencesResponse, error)
+	// TouchAction requires an ID and the UserID that owns that action. It
+	// marks the action as recently used without creating an occurrence
+	TouchAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error)
+	// CloneActions requires a UserID and a SourceUserID. It copies the
+	// actions of SourceUserID, but not their occurrences, to UserID, skipping
+	// any whose name UserID already has. The copied actions are returned
+	CloneActions(ctx context.Context, in *CloneActionsRequest, opts ...grpc.CallOption) (*ActionsResponse, error)
+	// ReadFirstOccurrence requires a UserID and returns the earliest
+	// occurrence across all of that user's actions, or an empty Occurrence
+	// if the user has none
+	ReadFirstOccurrence(ctx context.Context, in *User, opts ...grpc.CallOption) (*Occurrence, error)
+	// ReadDaySummary requires a UserID, a Date as YYYY-MM-DD and an IANA
+	// Timezone such as America/Los_Angeles. It returns each action with
+	// occurrences on that local date along with those occurrences, ordered
+	// by action ID and then by time. A day without occurrences returns no
+	// actions
+	ReadDaySummary(ctx context.Context, in *DaySummaryRequest, opts ...grpc.CallOption) (*DaySummaryResponse, error)
+	// ShiftOccurrences requires a UserID, an Offset such as "-7h" and Confirm
+	// set to true. It moves the Datetime of every occurrence of ActionID by
+	// Offset, or of every action of the user if ActionID is zero, in a
+	// single transaction. Nothing is shifted if any occurrence would end up
+	// in the future, or if a negative Offset would move one further back than
+	// OCCURRENCE_MAX_BACKFILL_AGE. The number of occurrences shifted is
+	// returned, an Offset of zero shifts none
+	ShiftOccurrences(ctx context.Context, in *ShiftOccurrencesRequest, opts ...grpc.CallOption) (*ShiftOccurrencesResponse, error)
+	// TransferAction requires a UserID, the ActionID of an action that user
+	// owns and a NewUserID. It gives the action, with its occurrences, to
+	// NewUserID, unless NewUserID already has an action with the same name.
+	// The transferred action is returned
+	TransferAction(ctx context.Context, in *TransferActionRequest, opts ...grpc.CallOption) (*Action, error)
+	// ReadUnloggedActions requires a UserID and returns that user's actions
+	// that have no occurrences, oldest first. If MinAgeDays is set only
+	// actions created at least that many days ago are returned
+	ReadUnloggedActions(ctx context.Context, in *ReadUnloggedActionsRequest, opts ...grpc.CallOption) (*ActionsResponse, error)
+	// ReadDueReminders requires a UserID and returns that user's actions
+	// with a Reminder due in the minute containing Time. If Time is empty
+	// the current time is used. See TIMESTAMP_PARSING in the README for
+	// accepted formats
+	ReadDueReminders(ctx context.Context, in *DueRemindersRequest, opts ...grpc.CallOption) (*ActionsResponse, error)
+	// ReadOccurrencesMulti requires a UserID and up to 50 ActionIDs owned
+	// by that user. It returns the occurrences of each action from Start,
+	// inclusive, to End, exclusive, grouped by action in the order the
+	// ActionIDs were given and ordered by time. Start and End are optional,
+	// see TIMESTAMP_PARSING in the README for accepted formats
+	ReadOccurrencesMulti(ctx context.Context, in *OccurrencesMultiRequest, opts ...grpc.CallOption) (*OccurrencesMultiResponse, error)
+	// CompactOccurrences requires a UserID, an ActionID owned by that user
+	// and an IANA Timezone. It keeps only the earliest occurrence of the
+	// action on each local day and deletes the rest in a single transaction.
+	// The Data of the deleted occurrences is appended to that of the kept one,
+	// one per line, and a Rating any of them has is kept. The number of
+	// occurrences deleted is returned. With DryRun nothing is changed and
+	// the number that would be deleted is returned
+	CompactOccurrences(ctx context.Context, in *CompactOccurrencesRequest, opts ...grpc.CallOption) (*CompactOccurrencesResponse, error)
+	// ReadUserUsage requires a UserID. It returns the number of actions and
+	// occurrences the user has and the total length in bytes of the Data of
+	// those occurrences
+	ReadUserUsage(ctx context.Context, in *User, opts ...grpc.CallOption) (*UserUsage, error)
+}
+
+type ambitionClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAmbitionClient(cc *grpc.ClientConn) AmbitionClient {
+	return &ambitionClient{cc}
+}
+
+func (c *ambitionClient) CreateAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error) {
+	out := new(Action)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/CreateAction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ambitionClient) CreateOccurrence(ctx context.Context, in *CreateOccurrenceRequest, opts ...grpc.CallOption) (*Occurrence, error) {
+	out := new(Occurrence)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/CreateOccurrence", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ambitionClient) ReadAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error) {
+	out := new(Action)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadAction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ambitionClient) ReadActions(ctx context.Context, in *ReadActionsRequest, opts ...grpc.CallOption) (*ActionsResponse, error) {
+	out := new(ActionsResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadActions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ambitionClient) ReadOccurrencesByDate(ctx context.Context, in *OccurrencesByDateReq, opts ...grpc.CallOption) (*OccurrencesResponse, error) {
+	out := new(OccurrencesResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadOccurrencesByDate", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ambitionClient) ReadOccurrences(ctx context.Context, in *Action, opts ...grpc.CallOption) (*OccurrencesResponse, error) {
+	out := new(OccurrencesResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadOccurrences", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ambitionClient) ReconcileOccurrences(ctx context.Context, in *ReconcileOccurrencesRequest, opts ...grpc.CallOption) (*ReconcileOccurrencesResponse, error) {
+	out := new(ReconcileOccurrencesResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReconcileOccurrences", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *ambitionClient) TouchAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error) {
+	out := new(Action)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/TouchAction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// Client API for Ambition service
+func (c *ambitionClient) CloneActions(ctx context.Context, in *CloneActionsRequest, opts ...grpc.CallOption) (*ActionsResponse, error) {
+	out := new(ActionsResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/CloneActions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-type AmbitionClient interface {
-	// CreateAction requires a UserID and a Name
-	CreateAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error)
-	// CreateOccurrence requires a UserID and Occurrence.ActionID
-	// TODO: If Datetime is provided it will be used
-	// TODO: If Data is provided it will be stored
-	CreateOccurrence(ctx context.Context, in *CreateOccurrenceRequest, opts ...grpc.CallOption) (*Occurrence, error)
-	// ReadAction requires either an ID, or BOTH a UserId and Name
-	ReadAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error)
-	// ReadAction
-	// TODO:
-	ReadActions(ctx context.Context, in *User, opts ...grpc.CallOption) (*ActionsResponse, error)
-	ReadOccurrencesByDate(ctx context.Context, in *OccurrencesByDateReq, opts ...grpc.CallOption) (*OccurrencesResponse, error)
-	// ReadOccurrences takes an action which must be populated with a
-	// UserID and an ActionID which must match the values for that action
-	// TODO:
-	ReadOccurrences(ctx context.Context, in *Action, opts ...grpc.CallOption) (*OccurrencesResponse, error)
+func (c *ambitionClient) ReadFirstOccurrence(ctx context.Context, in *User, opts ...grpc.CallOption) (*Occurrence, error) {
+	out := new(Occurrence)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadFirstOccurrence", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type ambitionClient struct {
-	cc *grpc.ClientConn
+func (c *ambitionClient) ReadDaySummary(ctx context.Context, in *DaySummaryRequest, opts ...grpc.CallOption) (*DaySummaryResponse, error) {
+	out := new(DaySummaryResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadDaySummary", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewAmbitionClient(cc *grpc.ClientConn) AmbitionClient {
-	return &ambitionClient{cc}
+func (c *ambitionClient) ShiftOccurrences(ctx context.Context, in *ShiftOccurrencesRequest, opts ...grpc.CallOption) (*ShiftOccurrencesResponse, error) {
+	out := new(ShiftOccurrencesResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ShiftOccurrences", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *ambitionClient) CreateAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error) {
+func (c *ambitionClient) TransferAction(ctx context.Context, in *TransferActionRequest, opts ...grpc.CallOption) (*Action, error) {
 	out := new(Action)
-	err := grpc.Invoke(ctx, "/ambition.Ambition/CreateAction", in, out, c.cc, opts...)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/TransferAction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ambitionClient) CreateOccurrence(ctx context.Context, in *CreateOccurrenceRequest, opts ...grpc.CallOption) (*Occurrence, error) {
-	out := new(Occurrence)
-	err := grpc.Invoke(ctx, "/ambition.Ambition/CreateOccurrence", in, out, c.cc, opts...)
+func (c *ambitionClient) ReadUnloggedActions(ctx context.Context, in *ReadUnloggedActionsRequest, opts ...grpc.CallOption) (*ActionsResponse, error) {
+	out := new(ActionsResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadUnloggedActions", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ambitionClient) ReadAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (*Action, error) {
-	out := new(Action)
-	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadAction", in, out, c.cc, opts...)
+func (c *ambitionClient) ReadDueReminders(ctx context.Context, in *DueRemindersRequest, opts ...grpc.CallOption) (*ActionsResponse, error) {
+	out := new(ActionsResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadDueReminders", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ambitionClient) ReadActions(ctx context.Context, in *User, opts ...grpc.CallOption) (*ActionsResponse, error) {
-	out := new(ActionsResponse)
-	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadActions", in, out, c.cc, opts...)
+func (c *ambitionClient) ReadOccurrencesMulti(ctx context.Context, in *OccurrencesMultiRequest, opts ...grpc.CallOption) (*OccurrencesMultiResponse, error) {
+	out := new(OccurrencesMultiResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadOccurrencesMulti", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ambitionClient) ReadOccurrencesByDate(ctx context.Context, in *OccurrencesByDateReq, opts ...grpc.CallOption) (*OccurrencesResponse, error) {
-	out := new(OccurrencesResponse)
-	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadOccurrencesByDate", in, out, c.cc, opts...)
+func (c *ambitionClient) CompactOccurrences(ctx context.Context, in *CompactOccurrencesRequest, opts ...grpc.CallOption) (*CompactOccurrencesResponse, error) {
+	out := new(CompactOccurrencesResponse)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/CompactOccurrences", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *ambitionClient) ReadOccurrences(ctx context.Context, in *Action, opts ...grpc.CallOption) (*OccurrencesResponse, error) {
-	out := new(OccurrencesResponse)
-	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadOccurrences", in, out, c.cc, opts...)
+func (c *ambitionClient) ReadUserUsage(ctx context.Context, in *User, opts ...grpc.CallOption) (*UserUsage, error) {
+	out := new(UserUsage)
+	err := grpc.Invoke(ctx, "/ambition.Ambition/ReadUserUsage", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -324,20 +1218,90 @@ func (c *ambitionClient) ReadOccurrences(ctx context.Context, in *Action, opts .
 type AmbitionServer interface {
 	// CreateAction requires a UserID and a Name
 	CreateAction(context.Context, *Action) (*Action, error)
-	// CreateOccurrence requires a UserID and Occurrence.ActionID
-	// TODO: If Datetime is provided it will be used
+	// CreateOccurrence requires a UserID and either Occurrence.ActionID or
+	// ActionName. With AutoCreateAction an action named ActionName is created
+	// for the user, together with the occurrence, if the user has none
+	// If Datetime is provided it will be used, otherwise the current time is
+	// used. See TIMESTAMP_PARSING in the README for accepted formats
+	// If Location is provided only its geohash is stored, as
+	// Occurrence.Geohash, at the precision set by GEOHASH_PRECISION
+	// If the action is Monotonic the Datetime must be later than that of
+	// every existing occurrence of the action
 	// TODO: If Data is provided it will be stored
 	CreateOccurrence(context.Context, *CreateOccurrenceRequest) (*Occurrence, error)
 	// ReadAction requires either an ID, or BOTH a UserId and Name
 	ReadAction(context.Context, *Action) (*Action, error)
-	// ReadAction
-	// TODO:
-	ReadActions(context.Context, *User) (*ActionsResponse, error)
+	// ReadActions requires a UserID and returns all actions for that user
+	// If MinOccurrences is set only actions with at least that many
+	// occurrences are returned
+	// If OrderBy is "recency" the most recently touched actions are first
+	ReadActions(context.Context, *ReadActionsRequest) (*ActionsResponse, error)
 	ReadOccurrencesByDate(context.Context, *OccurrencesByDateReq) (*OccurrencesResponse, error)
 	// ReadOccurrences takes an action which must be populated with a
 	// UserID and an ActionID which must match the values for that action
 	// TODO:
 	ReadOccurrences(context.Context, *Action) (*OccurrencesResponse, error)
+	// ReconcileOccurrences requires a UserID and an ActionID owned by that
+	// user. It compares the OccurrenceIDs a client has for the action
+	// against those stored and returns the IDs only each side has
+	ReconcileOccurrences(context.Context, *ReconcileOccurrencesRequest) (*ReconcileOccurrencesResponse, error)
+	// TouchAction requires an ID and the UserID that owns that action. It
+	// marks the action as recently used without creating an occurrence
+	TouchAction(context.Context, *Action) (*Action, error)
+	// CloneActions requires a UserID and a SourceUserID. It copies the
+	// actions of SourceUserID, but not their occurrences, to UserID, skipping
+	// any whose name UserID already has. The copied actions are returned
+	CloneActions(context.Context, *CloneActionsRequest) (*ActionsResponse, error)
+	// ReadFirstOccurrence requires a UserID and returns the earliest
+	// occurrence across all of that user's actions, or an empty Occurrence
+	// if the user has none
+	ReadFirstOccurrence(context.Context, *User) (*Occurrence, error)
+	// ReadDaySummary requires a UserID, a Date as YYYY-MM-DD and an IANA
+	// Timezone such as America/Los_Angeles. It returns each action with
+	// occurrences on that local date along with those occurrences, ordered
+	// by action ID and then by time. A day without occurrences returns no
+	// actions
+	ReadDaySummary(context.Context, *DaySummaryRequest) (*DaySummaryResponse, error)
+	// ShiftOccurrences requires a UserID, an Offset such as "-7h" and Confirm
+	// set to true. It moves the Datetime of every occurrence of ActionID by
+	// Offset, or of every action of the user if ActionID is zero, in a
+	// single transaction. Nothing is shifted if any occurrence would end up
+	// in the future, or if a negative Offset would move one further back than
+	// OCCURRENCE_MAX_BACKFILL_AGE. The number of occurrences shifted is
+	// returned, an Offset of zero shifts none
+	ShiftOccurrences(context.Context, *ShiftOccurrencesRequest) (*ShiftOccurrencesResponse, error)
+	// TransferAction requires a UserID, the ActionID of an action that user
+	// owns and a NewUserID. It gives the action, with its occurrences, to
+	// NewUserID, unless NewUserID already has an action with the same name.
+	// The transferred action is returned
+	TransferAction(context.Context, *TransferActionRequest) (*Action, error)
+	// ReadUnloggedActions requires a UserID and returns that user's actions
+	// that have no occurrences, oldest first. If MinAgeDays is set only
+	// actions created at least that many days ago are returned
+	ReadUnloggedActions(context.Context, *ReadUnloggedActionsRequest) (*ActionsResponse, error)
+	// ReadDueReminders requires a UserID and returns that user's actions
+	// with a Reminder due in the minute containing Time. If Time is empty
+	// the current time is used. See TIMESTAMP_PARSING in the README for
+	// accepted formats
+	ReadDueReminders(context.Context, *DueRemindersRequest) (*ActionsResponse, error)
+	// ReadOccurrencesMulti requires a UserID and up to 50 ActionIDs owned
+	// by that user. It returns the occurrences of each action from Start,
+	// inclusive, to End, exclusive, grouped by action in the order the
+	// ActionIDs were given and ordered by time. Start and End are optional,
+	// see TIMESTAMP_PARSING in the README for accepted formats
+	ReadOccurrencesMulti(context.Context, *OccurrencesMultiRequest) (*OccurrencesMultiResponse, error)
+	// CompactOccurrences requires a UserID, an ActionID owned by that user
+	// and an IANA Timezone. It keeps only the earliest occurrence of the
+	// action on each local day and deletes the rest in a single transaction.
+	// The Data of the deleted occurrences is appended to that of the kept one,
+	// one per line, and a Rating any of them has is kept. The number of
+	// occurrences deleted is returned. With DryRun nothing is changed and
+	// the number that would be deleted is returned
+	CompactOccurrences(context.Context, *CompactOccurrencesRequest) (*CompactOccurrencesResponse, error)
+	// ReadUserUsage requires a UserID. It returns the number of actions and
+	// occurrences the user has and the total length in bytes of the Data of
+	// those occurrences
+	ReadUserUsage(context.Context, *User) (*UserUsage, error)
 }
 
 func RegisterAmbitionServer(s *grpc.Server, srv AmbitionServer) {
@@ -399,7 +1363,7 @@ func _Ambition_ReadAction_Handler(srv interface{}, ctx context.Context, dec func
 }
 
 func _Ambition_ReadActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(User)
+	in := new(ReadActionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -411,7 +1375,7 @@ func _Ambition_ReadActions_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: "/ambition.Ambition/ReadActions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AmbitionServer).ReadActions(ctx, req.(*User))
+		return srv.(AmbitionServer).ReadActions(ctx, req.(*ReadActionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -452,6 +1416,222 @@ func _Ambition_ReadOccurrences_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Ambition_ReconcileOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).ReconcileOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/ReconcileOccurrences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).ReconcileOccurrences(ctx, req.(*ReconcileOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_TouchAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Action)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).TouchAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/TouchAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).TouchAction(ctx, req.(*Action))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_CloneActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).CloneActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/CloneActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).CloneActions(ctx, req.(*CloneActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_ReadFirstOccurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).ReadFirstOccurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/ReadFirstOccurrence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).ReadFirstOccurrence(ctx, req.(*User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_ReadDaySummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DaySummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).ReadDaySummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/ReadDaySummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).ReadDaySummary(ctx, req.(*DaySummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_ShiftOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShiftOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).ShiftOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/ShiftOccurrences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).ShiftOccurrences(ctx, req.(*ShiftOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_TransferAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).TransferAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/TransferAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).TransferAction(ctx, req.(*TransferActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_ReadUnloggedActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadUnloggedActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).ReadUnloggedActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/ReadUnloggedActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).ReadUnloggedActions(ctx, req.(*ReadUnloggedActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_ReadDueReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DueRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).ReadDueReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/ReadDueReminders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).ReadDueReminders(ctx, req.(*DueRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_ReadOccurrencesMulti_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OccurrencesMultiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).ReadOccurrencesMulti(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/ReadOccurrencesMulti",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).ReadOccurrencesMulti(ctx, req.(*OccurrencesMultiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_CompactOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).CompactOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/CompactOccurrences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).CompactOccurrences(ctx, req.(*CompactOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ambition_ReadUserUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AmbitionServer).ReadUserUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ambition.Ambition/ReadUserUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AmbitionServer).ReadUserUsage(ctx, req.(*User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Ambition_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ambition.Ambition",
 	HandlerType: (*AmbitionServer)(nil),
@@ -480,6 +1660,54 @@ var _Ambition_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReadOccurrences",
 			Handler:    _Ambition_ReadOccurrences_Handler,
 		},
+		{
+			MethodName: "ReconcileOccurrences",
+			Handler:    _Ambition_ReconcileOccurrences_Handler,
+		},
+		{
+			MethodName: "TouchAction",
+			Handler:    _Ambition_TouchAction_Handler,
+		},
+		{
+			MethodName: "CloneActions",
+			Handler:    _Ambition_CloneActions_Handler,
+		},
+		{
+			MethodName: "ReadFirstOccurrence",
+			Handler:    _Ambition_ReadFirstOccurrence_Handler,
+		},
+		{
+			MethodName: "ReadDaySummary",
+			Handler:    _Ambition_ReadDaySummary_Handler,
+		},
+		{
+			MethodName: "ShiftOccurrences",
+			Handler:    _Ambition_ShiftOccurrences_Handler,
+		},
+		{
+			MethodName: "TransferAction",
+			Handler:    _Ambition_TransferAction_Handler,
+		},
+		{
+			MethodName: "ReadUnloggedActions",
+			Handler:    _Ambition_ReadUnloggedActions_Handler,
+		},
+		{
+			MethodName: "ReadDueReminders",
+			Handler:    _Ambition_ReadDueReminders_Handler,
+		},
+		{
+			MethodName: "ReadOccurrencesMulti",
+			Handler:    _Ambition_ReadOccurrencesMulti_Handler,
+		},
+		{
+			MethodName: "CompactOccurrences",
+			Handler:    _Ambition_CompactOccurrences_Handler,
+		},
+		{
+			MethodName: "ReadUserUsage",
+			Handler:    _Ambition_ReadUserUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ambition.proto",
@@ -488,35 +1716,91 @@ var _Ambition_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("ambition.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 473 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x53, 0x5d, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xed, 0x28, 0x4d, 0x27, 0x55, 0x5a, 0x0d, 0x01, 0x8c, 0x05, 0x55, 0xd8, 0xa7, 0x08,
-	0x89, 0x58, 0x0a, 0x15, 0x0f, 0x48, 0x3c, 0x14, 0x0c, 0x52, 0x24, 0x0a, 0x92, 0x81, 0x03, 0x6c,
-	0x9c, 0xc5, 0x31, 0x6a, 0xbc, 0xe9, 0xee, 0xfa, 0xa1, 0xaf, 0x5c, 0x81, 0xa3, 0x70, 0x14, 0xae,
-	0xc0, 0x41, 0xd0, 0xfa, 0x6f, 0x57, 0x89, 0x2b, 0xc4, 0xdb, 0xce, 0xcc, 0x37, 0xdf, 0x7e, 0xf3,
-	0x8d, 0x06, 0xc6, 0x74, 0xbb, 0xca, 0x54, 0xc6, 0xf3, 0xf9, 0x4e, 0x70, 0xc5, 0x71, 0xd8, 0xc4,
-	0xc1, 0xfb, 0x34, 0x53, 0x9b, 0x62, 0x35, 0x4f, 0xf8, 0x36, 0xfc, 0x52, 0xe4, 0xec, 0x03, 0x5d,
-	0x85, 0x29, 0x7f, 0xae, 0x44, 0x21, 0x65, 0xb8, 0x66, 0xdf, 0x94, 0x60, 0x2c, 0x4c, 0x39, 0x4f,
-	0xaf, 0x99, 0xda, 0x64, 0x62, 0xbd, 0xa3, 0x42, 0xdd, 0x86, 0x34, 0xcf, 0xb9, 0xa2, 0x9a, 0x40,
-	0x56, 0x8c, 0xe4, 0x3b, 0x4c, 0x3e, 0x25, 0x49, 0x21, 0x04, 0xcb, 0x13, 0x26, 0xdf, 0xdc, 0x46,
-	0x54, 0xb1, 0x98, 0xdd, 0x60, 0x00, 0xc3, 0xcb, 0x44, 0x03, 0x97, 0x91, 0xef, 0x4c, 0x9d, 0x99,
-	0x17, 0xb7, 0x31, 0x3e, 0x86, 0xe3, 0xcf, 0x8a, 0x0a, 0xa5, 0xb1, 0xbe, 0x3b, 0x75, 0x66, 0xc7,
-	0xb1, 0x49, 0xa0, 0x0f, 0x47, 0xef, 0xf2, 0x75, 0x59, 0xf3, 0xca, 0x5a, 0x13, 0x92, 0x08, 0x06,
-	0x15, 0x07, 0x8e, 0xc1, 0x6d, 0x79, 0xdd, 0x65, 0x84, 0x08, 0xfd, 0x8f, 0x74, 0xdb, 0x90, 0x95,
-	0x6f, 0x7c, 0x00, 0x83, 0xaf, 0x92, 0x89, 0x65, 0x54, 0xd2, 0x78, 0x71, 0x1d, 0x91, 0x14, 0x1e,
-	0xbe, 0x15, 0x8c, 0x2a, 0x66, 0x74, 0xc7, 0xec, 0xa6, 0x60, 0x52, 0x59, 0x2d, 0x8e, 0xdd, 0x82,
-	0x17, 0x00, 0x06, 0x5c, 0x7e, 0x32, 0x5a, 0x4c, 0xe6, 0xad, 0xb7, 0x16, 0x91, 0x85, 0x23, 0x1b,
-	0xbb, 0xeb, 0x40, 0xb2, 0x6d, 0x90, 0xbb, 0x67, 0x50, 0x00, 0x43, 0x3d, 0xb0, 0xca, 0xb6, 0x8d,
-	0x07, 0x6d, 0xac, 0x47, 0x8d, 0xa8, 0xa2, 0x7e, 0xbf, 0x1a, 0x55, 0xbf, 0xc9, 0x39, 0xf4, 0xb5,
-	0xd2, 0xbb, 0xf4, 0x93, 0xd7, 0x70, 0x5a, 0x71, 0xcb, 0x98, 0xc9, 0x1d, 0xcf, 0x25, 0xc3, 0x67,
-	0x70, 0x54, 0xa7, 0x7c, 0x67, 0xea, 0xcd, 0x46, 0x8b, 0x33, 0x33, 0x4f, 0x55, 0x88, 0x1b, 0x00,
-	0xb9, 0x82, 0x7b, 0xd6, 0x8e, 0x5b, 0x8a, 0x97, 0x30, 0xb2, 0xd2, 0x35, 0x4d, 0xb7, 0x2d, 0x36,
-	0x70, 0xf1, 0xcb, 0x83, 0xe1, 0x65, 0x0d, 0xc2, 0x0b, 0x38, 0xa9, 0xb6, 0x51, 0x6f, 0xf6, 0x40,
-	0x46, 0x70, 0x90, 0x21, 0x3d, 0xbc, 0x82, 0xb3, 0xfd, 0x1d, 0xe2, 0x53, 0x83, 0xbb, 0x63, 0xbf,
-	0x41, 0xa7, 0x38, 0xd2, 0xc3, 0x05, 0x40, 0xcc, 0xe8, 0xfa, 0xbf, 0x24, 0xbc, 0x82, 0x91, 0xe9,
-	0x91, 0x38, 0x36, 0x10, 0x6d, 0x7a, 0xf0, 0x68, 0xbf, 0xa5, 0xf5, 0x8d, 0xf4, 0xf0, 0x1a, 0xee,
-	0xeb, 0xde, 0x83, 0xc3, 0xc1, 0xf3, 0x2e, 0x81, 0xe6, 0xaa, 0x82, 0x27, 0x9d, 0xf5, 0x96, 0x79,
-	0xf2, 0xe3, 0xf7, 0x9f, 0x9f, 0xee, 0x18, 0x4f, 0x42, 0x6e, 0xaa, 0x18, 0xc1, 0xe9, 0xde, 0x6f,
-	0x1d, 0x23, 0xfe, 0x83, 0xb9, 0xb7, 0x1a, 0x94, 0xf7, 0xfe, 0xe2, 0x6f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x49, 0xc8, 0xf4, 0xaf, 0x53, 0x04, 0x00, 0x00,
+	// 1365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xdd, 0x6e, 0xdb, 0xb6,
+	0x17, 0x8f, 0xec, 0x34, 0xb1, 0x8f, 0xd3, 0xd4, 0x65, 0xd2, 0x56, 0x55, 0xfd, 0xcf, 0xdf, 0xe3,
+	0xba, 0x22, 0x18, 0xb0, 0x04, 0x48, 0xb3, 0xde, 0xf5, 0x22, 0x8d, 0xda, 0x2d, 0x45, 0x9c, 0xa0,
+	0x4a, 0x8a, 0x15, 0x28, 0xb0, 0x41, 0x91, 0x19, 0x45, 0xab, 0x4d, 0xba, 0x14, 0xd5, 0xc2, 0xbb,
+	0x19, 0xb0, 0x5e, 0xef, 0x6a, 0x77, 0x7b, 0x8a, 0xbd, 0xcb, 0x80, 0xbd, 0xc0, 0xf6, 0x20, 0x03,
+	0x29, 0x4a, 0xa2, 0x65, 0xd9, 0xc9, 0xb6, 0xde, 0xe9, 0x7c, 0xf0, 0xf0, 0x7c, 0xfc, 0x78, 0xce,
+	0xb1, 0x61, 0xd5, 0x1f, 0x9e, 0x45, 0x22, 0x62, 0x74, 0x6b, 0xc4, 0x99, 0x60, 0xa8, 0x91, 0xd1,
+	0xce, 0xb3, 0x30, 0x12, 0x17, 0xc9, 0xd9, 0x56, 0xc0, 0x86, 0xdb, 0xa7, 0x09, 0x25, 0x87, 0xfe,
+	0xd9, 0x76, 0xc8, 0xbe, 0x10, 0x3c, 0x89, 0xe3, 0xed, 0x3e, 0x39, 0x17, 0x9c, 0x90, 0xed, 0x90,
+	0xb1, 0x70, 0x40, 0xc4, 0x45, 0xc4, 0xfb, 0x23, 0x9f, 0x8b, 0xf1, 0xb6, 0x4f, 0x29, 0x13, 0xbe,
+	0x34, 0x10, 0xa7, 0x16, 0xf1, 0xf7, 0xb0, 0x7e, 0x1c, 0x04, 0x09, 0xe7, 0x84, 0x06, 0x24, 0x7e,
+	0x32, 0x76, 0x7d, 0x41, 0x3c, 0xf2, 0x16, 0x39, 0xd0, 0xd8, 0x0b, 0xa4, 0xe2, 0x81, 0x6b, 0x5b,
+	0x5d, 0x6b, 0xb3, 0xee, 0xe5, 0x34, 0xea, 0x40, 0xf3, 0x44, 0xf8, 0x5c, 0x48, 0x5d, 0xbb, 0xd6,
+	0xb5, 0x36, 0x9b, 0x5e, 0xc1, 0x40, 0x36, 0x2c, 0x3f, 0xa5, 0x7d, 0x25, 0xab, 0x2b, 0x59, 0x46,
+	0xe2, 0x3f, 0x2c, 0x58, 0x4a, 0x8d, 0xa0, 0x55, 0xa8, 0xe5, 0x86, 0x6b, 0x07, 0x2e, 0x42, 0xb0,
+	0x78, 0xe4, 0x0f, 0x33, 0x6b, 0xea, 0x1b, 0xdd, 0x86, 0xa5, 0x97, 0x31, 0xe1, 0x07, 0xae, 0xb2,
+	0x53, 0xf7, 0x34, 0x85, 0xba, 0xd0, 0x3a, 0xf4, 0x63, 0x71, 0xca, 0x92, 0xe0, 0x82, 0xf4, 0xed,
+	0x6b, 0x4a, 0x68, 0xb2, 0xa4, 0x83, 0x3d, 0x46, 0x99, 0x60, 0x34, 0x0a, 0xec, 0xa5, 0xae, 0xb5,
+	0xd9, 0xf0, 0x0a, 0x86, 0x3c, 0xef, 0x92, 0x38, 0xe0, 0xd1, 0x48, 0xba, 0x62, 0x2f, 0xab, 0x2b,
+	0x4d, 0x16, 0xda, 0x82, 0x86, 0x47, 0x86, 0x11, 0xed, 0x13, 0x6e, 0x37, 0xba, 0xd6, 0x66, 0x6b,
+	0x07, 0x6d, 0xe5, 0x95, 0xc8, 0x24, 0x5e, 0xae, 0x83, 0x5f, 0x15, 0xfa, 0x68, 0x1d, 0xae, 0x9d,
+	0x46, 0x43, 0x12, 0xdb, 0x56, 0xb7, 0xbe, 0xd9, 0xf4, 0x52, 0x42, 0xa6, 0xf3, 0x1b, 0x42, 0xde,
+	0xf4, 0xfd, 0x71, 0x6c, 0xd7, 0xba, 0x75, 0x99, 0xce, 0x8c, 0x96, 0x32, 0xa9, 0xf4, 0x03, 0xa3,
+	0x59, 0xc6, 0x72, 0x1a, 0xff, 0x69, 0xc1, 0x9d, 0x7d, 0x4e, 0x7c, 0x41, 0x8a, 0x2a, 0x79, 0xe4,
+	0x6d, 0x42, 0x62, 0x61, 0xe4, 0xc7, 0x9a, 0xc8, 0xcf, 0x2e, 0x40, 0xa1, 0xac, 0x32, 0xda, 0xda,
+	0x59, 0x2f, 0xfc, 0x37, 0x0c, 0x19, 0x7a, 0x32, 0xe6, 0x43, 0x16, 0x28, 0x6c, 0xd8, 0xf5, 0x72,
+	0xcc, 0x99, 0xc4, 0xcb, 0x75, 0xd0, 0x06, 0x40, 0x5a, 0x4b, 0x55, 0xb7, 0x45, 0xe5, 0xb7, 0xc1,
+	0x41, 0x9f, 0x43, 0x7b, 0x2f, 0x11, 0x2c, 0x75, 0x3e, 0xe5, 0xab, 0x52, 0x35, 0xbc, 0x29, 0x3e,
+	0x76, 0x8b, 0xbb, 0x65, 0x36, 0x0e, 0x7d, 0x11, 0x89, 0xa4, 0x4f, 0x54, 0x5c, 0x96, 0x97, 0xd3,
+	0xb2, 0xae, 0x87, 0x8c, 0x86, 0xa9, 0xb0, 0xa6, 0x84, 0x05, 0x03, 0xff, 0x6a, 0x99, 0x81, 0x4f,
+	0x41, 0xcc, 0x44, 0x74, 0xad, 0x84, 0x68, 0x07, 0x1a, 0x12, 0xa1, 0x22, 0x1a, 0xe6, 0x25, 0xc8,
+	0x68, 0x09, 0x4d, 0xd7, 0x17, 0xbe, 0x0e, 0x51, 0x7d, 0xcb, 0xd4, 0x7b, 0xbe, 0x88, 0x68, 0xa8,
+	0xd1, 0xa7, 0x29, 0x89, 0xfd, 0xaf, 0x08, 0xbb, 0xf0, 0xe3, 0x0b, 0x05, 0xbb, 0xa6, 0x97, 0x91,
+	0x78, 0x03, 0x16, 0x65, 0x79, 0x66, 0x15, 0x0d, 0x53, 0x40, 0x1e, 0xf1, 0xfb, 0xa9, 0x47, 0xf1,
+	0x65, 0x25, 0x7e, 0x00, 0xab, 0xbd, 0x88, 0x1a, 0x0f, 0x57, 0x47, 0x54, 0xe2, 0x4a, 0x7f, 0x8e,
+	0x79, 0x9f, 0xf0, 0x27, 0xe3, 0xec, 0x2d, 0x6a, 0x12, 0x3f, 0x86, 0x1b, 0xf9, 0x5d, 0xf1, 0x88,
+	0xd1, 0x58, 0x56, 0x6c, 0x59, 0xb3, 0x14, 0x76, 0x5b, 0x3b, 0xed, 0x02, 0x00, 0xa9, 0xc0, 0xcb,
+	0x14, 0x70, 0x0f, 0xd6, 0x8c, 0x7b, 0x72, 0x13, 0x8f, 0xa0, 0x65, 0x3a, 0x95, 0x9a, 0xa9, 0xc6,
+	0x9e, 0xa9, 0x88, 0x5f, 0xc0, 0xda, 0xfe, 0x80, 0x51, 0x72, 0xc5, 0xf0, 0x31, 0xac, 0x9c, 0xb0,
+	0x84, 0x07, 0x44, 0x4b, 0xd3, 0xe0, 0x27, 0x78, 0xf8, 0x3d, 0xdc, 0xf3, 0x48, 0xc0, 0x68, 0x10,
+	0x0d, 0xc8, 0x84, 0xab, 0xf3, 0x4d, 0xcf, 0x43, 0xc9, 0x7d, 0xb8, 0x5e, 0x58, 0x3a, 0x70, 0x63,
+	0xbb, 0xae, 0x5e, 0xf2, 0x24, 0x13, 0x7f, 0x0b, 0x9d, 0xea, 0x8b, 0x75, 0x8e, 0x36, 0x00, 0x4e,
+	0x08, 0x7f, 0x47, 0xf8, 0x31, 0x1d, 0x8c, 0x55, 0x8a, 0xea, 0x9e, 0xc1, 0x91, 0xf2, 0xfd, 0x41,
+	0x44, 0xa8, 0x50, 0xf2, 0xb4, 0x59, 0x18, 0x1c, 0xfc, 0x1a, 0x6e, 0xba, 0xfe, 0xf8, 0x24, 0x19,
+	0x0e, 0x7d, 0x3e, 0xbe, 0x2c, 0x9c, 0x14, 0xbc, 0x79, 0x5f, 0x95, 0xdf, 0x73, 0xfb, 0xcd, 0x73,
+	0x40, 0xa6, 0x71, 0xed, 0xf2, 0x6e, 0x19, 0x19, 0x4e, 0x19, 0x19, 0xc6, 0xa1, 0x1c, 0x23, 0xbf,
+	0x59, 0xd0, 0x2e, 0x4b, 0xd1, 0x66, 0x36, 0x02, 0x94, 0xa3, 0x55, 0x18, 0xd3, 0x72, 0xd9, 0x48,
+	0xf7, 0x59, 0x42, 0x85, 0x2e, 0x43, 0x4a, 0x94, 0x11, 0x56, 0xbf, 0x22, 0xc2, 0x64, 0xed, 0xf6,
+	0xde, 0x11, 0xee, 0x87, 0x44, 0x3f, 0xdc, 0x45, 0xd5, 0x3e, 0x26, 0x99, 0xf8, 0x47, 0xb8, 0x73,
+	0x72, 0x11, 0x9d, 0x8b, 0x8f, 0x04, 0x98, 0xdb, 0xb0, 0x74, 0x7c, 0x7e, 0x1e, 0x13, 0xa1, 0xf3,
+	0xac, 0x29, 0xf9, 0x2c, 0xf7, 0x19, 0x3d, 0x8f, 0xf8, 0x50, 0xb9, 0xd1, 0xf0, 0x32, 0x12, 0xef,
+	0x82, 0x3d, 0xed, 0x80, 0xae, 0x82, 0x0d, 0xcb, 0x4a, 0x46, 0xfa, 0xda, 0x85, 0x8c, 0xc4, 0x11,
+	0xdc, 0x3a, 0xe5, 0x3e, 0x8d, 0xcf, 0x09, 0xd7, 0x49, 0xfc, 0x0f, 0x4e, 0x77, 0xa0, 0x79, 0x44,
+	0xde, 0x4f, 0x4c, 0xde, 0x82, 0x81, 0xf7, 0x60, 0xcd, 0x4d, 0x48, 0x36, 0xed, 0xe2, 0x2b, 0xe0,
+	0x4f, 0x62, 0x2b, 0xc3, 0x9f, 0xfc, 0xc6, 0xef, 0xe1, 0x8e, 0x11, 0x5e, 0x2f, 0x19, 0x88, 0xe8,
+	0x32, 0x33, 0x1d, 0x68, 0x66, 0xfe, 0x65, 0xf3, 0xb3, 0x60, 0x48, 0xa4, 0xa8, 0xf5, 0x43, 0x67,
+	0x39, 0x25, 0x50, 0x1b, 0xea, 0x4f, 0x69, 0x5f, 0xb7, 0x6d, 0xf9, 0x89, 0x5f, 0x80, 0x3d, 0x7d,
+	0xb1, 0x4e, 0xee, 0x97, 0x65, 0x88, 0xdf, 0x2b, 0x03, 0xd3, 0x2c, 0x49, 0x8e, 0xf1, 0x9f, 0x2d,
+	0xb8, 0x39, 0x25, 0x9e, 0xbb, 0x3c, 0x95, 0x00, 0x5c, 0xfb, 0xd7, 0x00, 0xae, 0x57, 0x01, 0xf8,
+	0x83, 0x05, 0x77, 0xf7, 0xd9, 0x70, 0xe4, 0x07, 0x1f, 0x0b, 0xc3, 0x73, 0xba, 0x85, 0xb4, 0xe7,
+	0xf2, 0xb1, 0x97, 0x50, 0x0d, 0x63, 0x4d, 0xe1, 0x23, 0x70, 0xaa, 0x9c, 0xd0, 0xa9, 0xbe, 0x0d,
+	0x4b, 0x3d, 0xc2, 0xc3, 0x1c, 0xc6, 0x9a, 0x52, 0xf8, 0x7e, 0x13, 0x8d, 0x46, 0xa4, 0xaf, 0x9d,
+	0xc8, 0x48, 0x7c, 0x0a, 0x8e, 0x1c, 0x8e, 0x2f, 0xe9, 0x80, 0x85, 0x21, 0xb9, 0xea, 0x90, 0xdc,
+	0x00, 0xe8, 0x45, 0x74, 0x2f, 0x24, 0x6e, 0xba, 0x75, 0x49, 0x99, 0xc1, 0xc1, 0x04, 0x9a, 0x52,
+	0xf3, 0x65, 0xec, 0x87, 0xea, 0x71, 0x15, 0xf5, 0x57, 0x97, 0x6b, 0x52, 0xae, 0x8b, 0xd3, 0x83,
+	0x76, 0xa2, 0x34, 0x1d, 0x68, 0xca, 0xad, 0xe0, 0xc9, 0x58, 0xa8, 0x8e, 0xa4, 0x5e, 0x4c, 0xce,
+	0xd8, 0xf9, 0xd0, 0x82, 0xc6, 0x9e, 0xae, 0x2e, 0xda, 0x85, 0x15, 0x73, 0xf3, 0x41, 0x53, 0xed,
+	0xcf, 0x99, 0xe2, 0xe0, 0x05, 0xd4, 0x83, 0x76, 0x79, 0x09, 0x44, 0x9f, 0x14, 0x7a, 0x33, 0x16,
+	0x44, 0xa7, 0x12, 0x55, 0x78, 0x01, 0xed, 0x00, 0x14, 0xbb, 0xc6, 0x15, 0x5d, 0xf8, 0x1a, 0x5a,
+	0xc5, 0x99, 0x18, 0x75, 0xcc, 0x7d, 0xb8, 0xbc, 0xb6, 0x38, 0x77, 0xcb, 0x06, 0xf2, 0xe2, 0xe3,
+	0x05, 0x34, 0x80, 0x5b, 0xf2, 0xc8, 0xd4, 0xaf, 0x0e, 0xb4, 0x51, 0xe5, 0x6e, 0xf1, 0x93, 0xc4,
+	0xf9, 0x5f, 0xa5, 0x3c, 0xb7, 0xbc, 0xfe, 0xd3, 0xef, 0x7f, 0xfd, 0x52, 0x5b, 0x45, 0x2b, 0xdb,
+	0xac, 0x90, 0x22, 0x17, 0x6e, 0x94, 0x6e, 0xab, 0x08, 0xf8, 0x12, 0xcb, 0x0b, 0x28, 0x84, 0xf5,
+	0xaa, 0x99, 0x8e, 0x3e, 0x33, 0xd3, 0x30, 0x73, 0xd9, 0x70, 0x1e, 0x5c, 0xa6, 0x96, 0x5f, 0xf4,
+	0x10, 0x5a, 0xea, 0x47, 0xcc, 0x3f, 0xaa, 0xcd, 0x73, 0x58, 0x31, 0xb7, 0x27, 0x64, 0x84, 0x53,
+	0xb1, 0x55, 0xcd, 0xaf, 0xce, 0x63, 0x58, 0x93, 0xf9, 0x7a, 0x16, 0xf1, 0x58, 0x98, 0xcb, 0x74,
+	0x71, 0x46, 0xbe, 0x99, 0x99, 0xd0, 0xea, 0xc1, 0xaa, 0x3c, 0x6e, 0x0c, 0x7c, 0xa3, 0x8f, 0x4e,
+	0xad, 0x2d, 0x4e, 0xa7, 0x5a, 0x98, 0x7b, 0xf3, 0x1a, 0xda, 0xe5, 0x71, 0x68, 0x02, 0x7f, 0xc6,
+	0xac, 0x76, 0xf0, 0x3c, 0x95, 0xdc, 0xf8, 0x53, 0x58, 0x9d, 0x9c, 0x9a, 0xe8, 0xff, 0xc5, 0xb9,
+	0xca, 0x79, 0x5a, 0x99, 0xfd, 0x57, 0xb0, 0x56, 0xd1, 0x9c, 0xd0, 0xfd, 0xc9, 0x17, 0x52, 0xdd,
+	0xbb, 0xe6, 0xd7, 0xe2, 0x08, 0xda, 0x2a, 0x99, 0xc6, 0xbc, 0x35, 0x6b, 0x5b, 0x31, 0x87, 0xe7,
+	0xdb, 0xfb, 0x0e, 0xd6, 0x4b, 0x6f, 0x41, 0xcd, 0x40, 0x33, 0xa3, 0x33, 0x06, 0xb3, 0x83, 0xe7,
+	0xa9, 0xe4, 0x17, 0xf8, 0x80, 0xa6, 0xfb, 0x3e, 0xfa, 0xd4, 0x80, 0xe3, 0xac, 0xd1, 0xe4, 0xdc,
+	0x9f, 0xaf, 0x94, 0x5f, 0xf1, 0x08, 0xae, 0xab, 0x74, 0xe6, 0x8d, 0xbb, 0x8c, 0xcc, 0xb5, 0x49,
+	0x5a, 0x29, 0xe1, 0x85, 0xb3, 0x25, 0xf5, 0x77, 0xc7, 0xc3, 0xbf, 0x07, 0x00, 0x46, 0xb0, 0x12,
+	0x37, 0x52, 0x11, 0x00, 0x00,
 }
@@ -65,22 +65,99 @@ func submain() int {
 
 	fsReadOccurrencesByDate := flag.NewFlagSet("readoccurrencesbydate", flag.ExitOnError)
 
+	fsReconcileOccurrences := flag.NewFlagSet("reconcileoccurrences", flag.ExitOnError)
+
+	fsTouchAction := flag.NewFlagSet("touchaction", flag.ExitOnError)
+
+	fsCloneActions := flag.NewFlagSet("cloneactions", flag.ExitOnError)
+
+	fsReadFirstOccurrence := flag.NewFlagSet("readfirstoccurrence", flag.ExitOnError)
+
+	fsReadDaySummary := flag.NewFlagSet("readdaysummary", flag.ExitOnError)
+
+	fsShiftOccurrences := flag.NewFlagSet("shiftoccurrences", flag.ExitOnError)
+
+	fsTransferAction := flag.NewFlagSet("transferaction", flag.ExitOnError)
+
+	fsReadUnloggedActions := flag.NewFlagSet("readunloggedactions", flag.ExitOnError)
+
+	fsReadDueReminders := flag.NewFlagSet("readduereminders", flag.ExitOnError)
+
+	fsReadOccurrencesMulti := flag.NewFlagSet("readoccurrencesmulti", flag.ExitOnError)
+
+	fsCompactOccurrences := flag.NewFlagSet("compactoccurrences", flag.ExitOnError)
+
+	fsReadUserUsage := flag.NewFlagSet("readuserusage", flag.ExitOnError)
+
 	var (
-		flagUserIDReadActions              = fsReadActions.Int64("userid", 0, "")
-		flagActionIDReadOccurrencesByDate  = fsReadOccurrencesByDate.Int64("actionid", 0, "")
-		flagStartDateReadOccurrencesByDate = fsReadOccurrencesByDate.String("startdate", "", "")
-		flagEndDateReadOccurrencesByDate   = fsReadOccurrencesByDate.String("enddate", "", "")
-		flagIDReadOccurrences              = fsReadOccurrences.Int64("id", 0, "")
-		flagNameReadOccurrences            = fsReadOccurrences.String("name", "", "")
-		flagUserIDReadOccurrences          = fsReadOccurrences.Int64("userid", 0, "")
-		flagIDCreateAction                 = fsCreateAction.Int64("id", 0, "")
-		flagNameCreateAction               = fsCreateAction.String("name", "", "")
-		flagUserIDCreateAction             = fsCreateAction.Int64("userid", 0, "")
-		flagUserIDCreateOccurrence         = fsCreateOccurrence.Int64("userid", 0, "")
-		flagOccurrenceCreateOccurrence     = fsCreateOccurrence.String("occurrence", "", "")
-		flagIDReadAction                   = fsReadAction.Int64("id", 0, "")
-		flagNameReadAction                 = fsReadAction.String("name", "", "")
-		flagUserIDReadAction               = fsReadAction.Int64("userid", 0, "")
+		flagUserIDReadActions                 = fsReadActions.Int64("userid", 0, "")
+		flagMinOccurrencesReadActions         = fsReadActions.Int64("minoccurrences", 0, "")
+		flagOrderByReadActions                = fsReadActions.String("orderby", "", "")
+		flagActionIDReadOccurrencesByDate     = fsReadOccurrencesByDate.Int64("actionid", 0, "")
+		flagStartDateReadOccurrencesByDate    = fsReadOccurrencesByDate.String("startdate", "", "")
+		flagEndDateReadOccurrencesByDate      = fsReadOccurrencesByDate.String("enddate", "", "")
+		flagIDReadOccurrences                 = fsReadOccurrences.Int64("id", 0, "")
+		flagNameReadOccurrences               = fsReadOccurrences.String("name", "", "")
+		flagUserIDReadOccurrences             = fsReadOccurrences.Int64("userid", 0, "")
+		flagLastTouchedReadOccurrences        = fsReadOccurrences.Int64("lasttouched", 0, "")
+		flagMonotonicReadOccurrences          = fsReadOccurrences.Bool("monotonic", false, "")
+		flagDescriptionReadOccurrences        = fsReadOccurrences.String("description", "", "")
+		flagReminderReadOccurrences           = fsReadOccurrences.String("reminder", "", "")
+		flagIDCreateAction                    = fsCreateAction.Int64("id", 0, "")
+		flagNameCreateAction                  = fsCreateAction.String("name", "", "")
+		flagUserIDCreateAction                = fsCreateAction.Int64("userid", 0, "")
+		flagLastTouchedCreateAction           = fsCreateAction.Int64("lasttouched", 0, "")
+		flagMonotonicCreateAction             = fsCreateAction.Bool("monotonic", false, "")
+		flagDescriptionCreateAction           = fsCreateAction.String("description", "", "")
+		flagReminderCreateAction              = fsCreateAction.String("reminder", "", "")
+		flagUserIDCreateOccurrence            = fsCreateOccurrence.Int64("userid", 0, "")
+		flagOccurrenceCreateOccurrence        = fsCreateOccurrence.String("occurrence", "", "")
+		flagLocationCreateOccurrence          = fsCreateOccurrence.String("location", "", "")
+		flagActionNameCreateOccurrence        = fsCreateOccurrence.String("actionname", "", "")
+		flagAutoCreateActionCreateOccurrence  = fsCreateOccurrence.Bool("autocreateaction", false, "")
+		flagIDReadAction                      = fsReadAction.Int64("id", 0, "")
+		flagNameReadAction                    = fsReadAction.String("name", "", "")
+		flagUserIDReadAction                  = fsReadAction.Int64("userid", 0, "")
+		flagLastTouchedReadAction             = fsReadAction.Int64("lasttouched", 0, "")
+		flagMonotonicReadAction               = fsReadAction.Bool("monotonic", false, "")
+		flagDescriptionReadAction             = fsReadAction.String("description", "", "")
+		flagReminderReadAction                = fsReadAction.String("reminder", "", "")
+		flagUserIDReconcileOccurrences        = fsReconcileOccurrences.Int64("userid", 0, "")
+		flagActionIDReconcileOccurrences      = fsReconcileOccurrences.Int64("actionid", 0, "")
+		flagOccurrenceIDsReconcileOccurrences = fsReconcileOccurrences.String("occurrenceids", "", "")
+		flagIDTouchAction                     = fsTouchAction.Int64("id", 0, "")
+		flagNameTouchAction                   = fsTouchAction.String("name", "", "")
+		flagUserIDTouchAction                 = fsTouchAction.Int64("userid", 0, "")
+		flagLastTouchedTouchAction            = fsTouchAction.Int64("lasttouched", 0, "")
+		flagMonotonicTouchAction              = fsTouchAction.Bool("monotonic", false, "")
+		flagDescriptionTouchAction            = fsTouchAction.String("description", "", "")
+		flagReminderTouchAction               = fsTouchAction.String("reminder", "", "")
+		flagUserIDCloneActions                = fsCloneActions.Int64("userid", 0, "")
+		flagSourceUserIDCloneActions          = fsCloneActions.Int64("sourceuserid", 0, "")
+		flagUserIDReadFirstOccurrence         = fsReadFirstOccurrence.Int64("userid", 0, "")
+		flagUserIDReadDaySummary              = fsReadDaySummary.Int64("userid", 0, "")
+		flagDateReadDaySummary                = fsReadDaySummary.String("date", "", "")
+		flagTimezoneReadDaySummary            = fsReadDaySummary.String("timezone", "", "")
+		flagUserIDShiftOccurrences            = fsShiftOccurrences.Int64("userid", 0, "")
+		flagActionIDShiftOccurrences          = fsShiftOccurrences.Int64("actionid", 0, "")
+		flagOffsetShiftOccurrences            = fsShiftOccurrences.String("offset", "", "")
+		flagConfirmShiftOccurrences           = fsShiftOccurrences.Bool("confirm", false, "")
+		flagUserIDTransferAction              = fsTransferAction.Int64("userid", 0, "")
+		flagActionIDTransferAction            = fsTransferAction.Int64("actionid", 0, "")
+		flagNewUserIDTransferAction           = fsTransferAction.Int64("newuserid", 0, "")
+		flagUserIDReadUnloggedActions         = fsReadUnloggedActions.Int64("userid", 0, "")
+		flagMinAgeDaysReadUnloggedActions     = fsReadUnloggedActions.Int64("minagedays", 0, "")
+		flagUserIDReadDueReminders            = fsReadDueReminders.Int64("userid", 0, "")
+		flagTimeReadDueReminders              = fsReadDueReminders.String("time", "", "")
+		flagUserIDReadOccurrencesMulti        = fsReadOccurrencesMulti.Int64("userid", 0, "")
+		flagActionIDsReadOccurrencesMulti     = fsReadOccurrencesMulti.String("actionids", "", "")
+		flagStartReadOccurrencesMulti         = fsReadOccurrencesMulti.String("start", "", "")
+		flagEndReadOccurrencesMulti           = fsReadOccurrencesMulti.String("end", "", "")
+		flagUserIDCompactOccurrences          = fsCompactOccurrences.Int64("userid", 0, "")
+		flagActionIDCompactOccurrences        = fsCompactOccurrences.Int64("actionid", 0, "")
+		flagTimezoneCompactOccurrences        = fsCompactOccurrences.String("timezone", "", "")
+		flagDryRunCompactOccurrences          = fsCompactOccurrences.Bool("dryrun", false, "")
+		flagUserIDReadUserUsage               = fsReadUserUsage.Int64("userid", 0, "")
 	)
 
 	flag.Usage = func() {
@@ -94,6 +171,18 @@ func submain() int {
 		fmt.Fprintf(os.Stderr, "  %s\n", "readactions")
 		fmt.Fprintf(os.Stderr, "  %s\n", "readoccurrences")
 		fmt.Fprintf(os.Stderr, "  %s\n", "readoccurrencesbydate")
+		fmt.Fprintf(os.Stderr, "  %s\n", "reconcileoccurrences")
+		fmt.Fprintf(os.Stderr, "  %s\n", "touchaction")
+		fmt.Fprintf(os.Stderr, "  %s\n", "cloneactions")
+		fmt.Fprintf(os.Stderr, "  %s\n", "readfirstoccurrence")
+		fmt.Fprintf(os.Stderr, "  %s\n", "readdaysummary")
+		fmt.Fprintf(os.Stderr, "  %s\n", "shiftoccurrences")
+		fmt.Fprintf(os.Stderr, "  %s\n", "transferaction")
+		fmt.Fprintf(os.Stderr, "  %s\n", "readunloggedactions")
+		fmt.Fprintf(os.Stderr, "  %s\n", "readduereminders")
+		fmt.Fprintf(os.Stderr, "  %s\n", "readoccurrencesmulti")
+		fmt.Fprintf(os.Stderr, "  %s\n", "compactoccurrences")
+		fmt.Fprintf(os.Stderr, "  %s\n", "readuserusage")
 	}
 	if len(os.Args) < 2 {
 		flag.Usage()
@@ -134,8 +223,19 @@ func submain() int {
 		IDCreateAction := *flagIDCreateAction
 		NameCreateAction := *flagNameCreateAction
 		UserIDCreateAction := *flagUserIDCreateAction
+		LastTouchedCreateAction := *flagLastTouchedCreateAction
+		MonotonicCreateAction := *flagMonotonicCreateAction
+		DescriptionCreateAction := *flagDescriptionCreateAction
 
-		request, err := handlers.CreateAction(IDCreateAction, NameCreateAction, UserIDCreateAction)
+		var ReminderCreateAction pb.Reminder
+		if flagReminderCreateAction != nil && len(*flagReminderCreateAction) > 0 {
+			err = json.Unmarshal([]byte(*flagReminderCreateAction), &ReminderCreateAction)
+			if err != nil {
+				panic(errors.Wrapf(err, "unmarshalling ReminderCreateAction from %v:", flagReminderCreateAction))
+			}
+		}
+
+		request, err := handlers.CreateAction(IDCreateAction, NameCreateAction, UserIDCreateAction, LastTouchedCreateAction, MonotonicCreateAction, DescriptionCreateAction, ReminderCreateAction)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error calling handlers.CreateAction: %v\n", err)
 			return 1
@@ -147,7 +247,7 @@ func submain() int {
 			return 1
 		}
 		fmt.Println("Client Requested with:")
-		fmt.Println(IDCreateAction, NameCreateAction, UserIDCreateAction)
+		fmt.Println(IDCreateAction, NameCreateAction, UserIDCreateAction, LastTouchedCreateAction, MonotonicCreateAction, DescriptionCreateAction, ReminderCreateAction)
 		fmt.Println("Server Responded with:")
 		fmt.Println(v)
 
@@ -164,7 +264,20 @@ func submain() int {
 			}
 		}
 
-		request, err := handlers.CreateOccurrence(UserIDCreateOccurrence, OccurrenceCreateOccurrence)
+		// Without the flag no Location is sent, as 0,0 is a real coordinate
+		var LocationCreateOccurrence *pb.Location
+		if flagLocationCreateOccurrence != nil && len(*flagLocationCreateOccurrence) > 0 {
+			LocationCreateOccurrence = &pb.Location{}
+			err = json.Unmarshal([]byte(*flagLocationCreateOccurrence), LocationCreateOccurrence)
+			if err != nil {
+				panic(errors.Wrapf(err, "unmarshalling LocationCreateOccurrence from %v:", flagLocationCreateOccurrence))
+			}
+		}
+
+		ActionNameCreateOccurrence := *flagActionNameCreateOccurrence
+		AutoCreateActionCreateOccurrence := *flagAutoCreateActionCreateOccurrence
+
+		request, err := handlers.CreateOccurrence(UserIDCreateOccurrence, OccurrenceCreateOccurrence, LocationCreateOccurrence, ActionNameCreateOccurrence, AutoCreateActionCreateOccurrence)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error calling handlers.CreateOccurrence: %v\n", err)
 			return 1
@@ -176,7 +289,7 @@ func submain() int {
 			return 1
 		}
 		fmt.Println("Client Requested with:")
-		fmt.Println(UserIDCreateOccurrence, OccurrenceCreateOccurrence)
+		fmt.Println(UserIDCreateOccurrence, OccurrenceCreateOccurrence, LocationCreateOccurrence, ActionNameCreateOccurrence, AutoCreateActionCreateOccurrence)
 		fmt.Println("Server Responded with:")
 		fmt.Println(v)
 
@@ -186,8 +299,19 @@ func submain() int {
 		IDReadAction := *flagIDReadAction
 		NameReadAction := *flagNameReadAction
 		UserIDReadAction := *flagUserIDReadAction
+		LastTouchedReadAction := *flagLastTouchedReadAction
+		MonotonicReadAction := *flagMonotonicReadAction
+		DescriptionReadAction := *flagDescriptionReadAction
+
+		var ReminderReadAction pb.Reminder
+		if flagReminderReadAction != nil && len(*flagReminderReadAction) > 0 {
+			err = json.Unmarshal([]byte(*flagReminderReadAction), &ReminderReadAction)
+			if err != nil {
+				panic(errors.Wrapf(err, "unmarshalling ReminderReadAction from %v:", flagReminderReadAction))
+			}
+		}
 
-		request, err := handlers.ReadAction(IDReadAction, NameReadAction, UserIDReadAction)
+		request, err := handlers.ReadAction(IDReadAction, NameReadAction, UserIDReadAction, LastTouchedReadAction, MonotonicReadAction, DescriptionReadAction, ReminderReadAction)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error calling handlers.ReadAction: %v\n", err)
 			return 1
@@ -199,7 +323,7 @@ func submain() int {
 			return 1
 		}
 		fmt.Println("Client Requested with:")
-		fmt.Println(IDReadAction, NameReadAction, UserIDReadAction)
+		fmt.Println(IDReadAction, NameReadAction, UserIDReadAction, LastTouchedReadAction, MonotonicReadAction, DescriptionReadAction, ReminderReadAction)
 		fmt.Println("Server Responded with:")
 		fmt.Println(v)
 
@@ -207,8 +331,10 @@ func submain() int {
 		fsReadActions.Parse(flag.Args()[1:])
 
 		UserIDReadActions := *flagUserIDReadActions
+		MinOccurrencesReadActions := *flagMinOccurrencesReadActions
+		OrderByReadActions := *flagOrderByReadActions
 
-		request, err := handlers.ReadActions(UserIDReadActions)
+		request, err := handlers.ReadActions(UserIDReadActions, MinOccurrencesReadActions, OrderByReadActions)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error calling handlers.ReadActions: %v\n", err)
 			return 1
@@ -220,7 +346,7 @@ func submain() int {
 			return 1
 		}
 		fmt.Println("Client Requested with:")
-		fmt.Println(UserIDReadActions)
+		fmt.Println(UserIDReadActions, MinOccurrencesReadActions, OrderByReadActions)
 		fmt.Println("Server Responded with:")
 		fmt.Println(v)
 
@@ -230,8 +356,19 @@ func submain() int {
 		IDReadOccurrences := *flagIDReadOccurrences
 		NameReadOccurrences := *flagNameReadOccurrences
 		UserIDReadOccurrences := *flagUserIDReadOccurrences
+		LastTouchedReadOccurrences := *flagLastTouchedReadOccurrences
+		MonotonicReadOccurrences := *flagMonotonicReadOccurrences
+		DescriptionReadOccurrences := *flagDescriptionReadOccurrences
 
-		request, err := handlers.ReadOccurrences(IDReadOccurrences, NameReadOccurrences, UserIDReadOccurrences)
+		var ReminderReadOccurrences pb.Reminder
+		if flagReminderReadOccurrences != nil && len(*flagReminderReadOccurrences) > 0 {
+			err = json.Unmarshal([]byte(*flagReminderReadOccurrences), &ReminderReadOccurrences)
+			if err != nil {
+				panic(errors.Wrapf(err, "unmarshalling ReminderReadOccurrences from %v:", flagReminderReadOccurrences))
+			}
+		}
+
+		request, err := handlers.ReadOccurrences(IDReadOccurrences, NameReadOccurrences, UserIDReadOccurrences, LastTouchedReadOccurrences, MonotonicReadOccurrences, DescriptionReadOccurrences, ReminderReadOccurrences)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error calling handlers.ReadOccurrences: %v\n", err)
 			return 1
@@ -243,7 +380,7 @@ func submain() int {
 			return 1
 		}
 		fmt.Println("Client Requested with:")
-		fmt.Println(IDReadOccurrences, NameReadOccurrences, UserIDReadOccurrences)
+		fmt.Println(IDReadOccurrences, NameReadOccurrences, UserIDReadOccurrences, LastTouchedReadOccurrences, MonotonicReadOccurrences, DescriptionReadOccurrences, ReminderReadOccurrences)
 		fmt.Println("Server Responded with:")
 		fmt.Println(v)
 
@@ -270,6 +407,303 @@ func submain() int {
 		fmt.Println("Server Responded with:")
 		fmt.Println(v)
 
+	case "reconcileoccurrences":
+		fsReconcileOccurrences.Parse(flag.Args()[1:])
+
+		UserIDReconcileOccurrences := *flagUserIDReconcileOccurrences
+		ActionIDReconcileOccurrences := *flagActionIDReconcileOccurrences
+
+		var OccurrenceIDsReconcileOccurrences []int64
+		if flagOccurrenceIDsReconcileOccurrences != nil && len(*flagOccurrenceIDsReconcileOccurrences) > 0 {
+			err = json.Unmarshal([]byte(*flagOccurrenceIDsReconcileOccurrences), &OccurrenceIDsReconcileOccurrences)
+			if err != nil {
+				panic(errors.Wrapf(err, "unmarshalling OccurrenceIDsReconcileOccurrences from %v:", flagOccurrenceIDsReconcileOccurrences))
+			}
+		}
+
+		request, err := handlers.ReconcileOccurrences(UserIDReconcileOccurrences, ActionIDReconcileOccurrences, OccurrenceIDsReconcileOccurrences)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.ReconcileOccurrences: %v\n", err)
+			return 1
+		}
+
+		v, err := service.ReconcileOccurrences(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.ReconcileOccurrences: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDReconcileOccurrences, ActionIDReconcileOccurrences, OccurrenceIDsReconcileOccurrences)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "touchaction":
+		fsTouchAction.Parse(flag.Args()[1:])
+
+		IDTouchAction := *flagIDTouchAction
+		NameTouchAction := *flagNameTouchAction
+		UserIDTouchAction := *flagUserIDTouchAction
+		LastTouchedTouchAction := *flagLastTouchedTouchAction
+		MonotonicTouchAction := *flagMonotonicTouchAction
+		DescriptionTouchAction := *flagDescriptionTouchAction
+
+		var ReminderTouchAction pb.Reminder
+		if flagReminderTouchAction != nil && len(*flagReminderTouchAction) > 0 {
+			err = json.Unmarshal([]byte(*flagReminderTouchAction), &ReminderTouchAction)
+			if err != nil {
+				panic(errors.Wrapf(err, "unmarshalling ReminderTouchAction from %v:", flagReminderTouchAction))
+			}
+		}
+
+		request, err := handlers.TouchAction(IDTouchAction, NameTouchAction, UserIDTouchAction, LastTouchedTouchAction, MonotonicTouchAction, DescriptionTouchAction, ReminderTouchAction)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.TouchAction: %v\n", err)
+			return 1
+		}
+
+		v, err := service.TouchAction(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.TouchAction: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(IDTouchAction, NameTouchAction, UserIDTouchAction, LastTouchedTouchAction, MonotonicTouchAction, DescriptionTouchAction, ReminderTouchAction)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "cloneactions":
+		fsCloneActions.Parse(flag.Args()[1:])
+
+		UserIDCloneActions := *flagUserIDCloneActions
+		SourceUserIDCloneActions := *flagSourceUserIDCloneActions
+
+		request, err := handlers.CloneActions(UserIDCloneActions, SourceUserIDCloneActions)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.CloneActions: %v\n", err)
+			return 1
+		}
+
+		v, err := service.CloneActions(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.CloneActions: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDCloneActions, SourceUserIDCloneActions)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "readfirstoccurrence":
+		fsReadFirstOccurrence.Parse(flag.Args()[1:])
+
+		UserIDReadFirstOccurrence := *flagUserIDReadFirstOccurrence
+
+		request, err := handlers.ReadFirstOccurrence(UserIDReadFirstOccurrence)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.ReadFirstOccurrence: %v\n", err)
+			return 1
+		}
+
+		v, err := service.ReadFirstOccurrence(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.ReadFirstOccurrence: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDReadFirstOccurrence)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "readdaysummary":
+		fsReadDaySummary.Parse(flag.Args()[1:])
+
+		UserIDReadDaySummary := *flagUserIDReadDaySummary
+		DateReadDaySummary := *flagDateReadDaySummary
+		TimezoneReadDaySummary := *flagTimezoneReadDaySummary
+
+		request, err := handlers.ReadDaySummary(UserIDReadDaySummary, DateReadDaySummary, TimezoneReadDaySummary)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.ReadDaySummary: %v\n", err)
+			return 1
+		}
+
+		v, err := service.ReadDaySummary(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.ReadDaySummary: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDReadDaySummary, DateReadDaySummary, TimezoneReadDaySummary)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "shiftoccurrences":
+		fsShiftOccurrences.Parse(flag.Args()[1:])
+
+		UserIDShiftOccurrences := *flagUserIDShiftOccurrences
+		ActionIDShiftOccurrences := *flagActionIDShiftOccurrences
+		OffsetShiftOccurrences := *flagOffsetShiftOccurrences
+		ConfirmShiftOccurrences := *flagConfirmShiftOccurrences
+
+		request, err := handlers.ShiftOccurrences(UserIDShiftOccurrences, ActionIDShiftOccurrences, OffsetShiftOccurrences, ConfirmShiftOccurrences)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.ShiftOccurrences: %v\n", err)
+			return 1
+		}
+
+		v, err := service.ShiftOccurrences(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.ShiftOccurrences: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDShiftOccurrences, ActionIDShiftOccurrences, OffsetShiftOccurrences, ConfirmShiftOccurrences)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "transferaction":
+		fsTransferAction.Parse(flag.Args()[1:])
+
+		UserIDTransferAction := *flagUserIDTransferAction
+		ActionIDTransferAction := *flagActionIDTransferAction
+		NewUserIDTransferAction := *flagNewUserIDTransferAction
+
+		request, err := handlers.TransferAction(UserIDTransferAction, ActionIDTransferAction, NewUserIDTransferAction)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.TransferAction: %v\n", err)
+			return 1
+		}
+
+		v, err := service.TransferAction(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.TransferAction: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDTransferAction, ActionIDTransferAction, NewUserIDTransferAction)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "readunloggedactions":
+		fsReadUnloggedActions.Parse(flag.Args()[1:])
+
+		UserIDReadUnloggedActions := *flagUserIDReadUnloggedActions
+		MinAgeDaysReadUnloggedActions := *flagMinAgeDaysReadUnloggedActions
+
+		request, err := handlers.ReadUnloggedActions(UserIDReadUnloggedActions, MinAgeDaysReadUnloggedActions)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.ReadUnloggedActions: %v\n", err)
+			return 1
+		}
+
+		v, err := service.ReadUnloggedActions(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.ReadUnloggedActions: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDReadUnloggedActions, MinAgeDaysReadUnloggedActions)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "readduereminders":
+		fsReadDueReminders.Parse(flag.Args()[1:])
+
+		UserIDReadDueReminders := *flagUserIDReadDueReminders
+		TimeReadDueReminders := *flagTimeReadDueReminders
+
+		request, err := handlers.ReadDueReminders(UserIDReadDueReminders, TimeReadDueReminders)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.ReadDueReminders: %v\n", err)
+			return 1
+		}
+
+		v, err := service.ReadDueReminders(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.ReadDueReminders: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDReadDueReminders, TimeReadDueReminders)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "readoccurrencesmulti":
+		fsReadOccurrencesMulti.Parse(flag.Args()[1:])
+
+		UserIDReadOccurrencesMulti := *flagUserIDReadOccurrencesMulti
+
+		var ActionIDsReadOccurrencesMulti []int64
+		if flagActionIDsReadOccurrencesMulti != nil && len(*flagActionIDsReadOccurrencesMulti) > 0 {
+			err = json.Unmarshal([]byte(*flagActionIDsReadOccurrencesMulti), &ActionIDsReadOccurrencesMulti)
+			if err != nil {
+				panic(errors.Wrapf(err, "unmarshalling ActionIDsReadOccurrencesMulti from %v:", flagActionIDsReadOccurrencesMulti))
+			}
+		}
+		StartReadOccurrencesMulti := *flagStartReadOccurrencesMulti
+		EndReadOccurrencesMulti := *flagEndReadOccurrencesMulti
+
+		request, err := handlers.ReadOccurrencesMulti(UserIDReadOccurrencesMulti, ActionIDsReadOccurrencesMulti, StartReadOccurrencesMulti, EndReadOccurrencesMulti)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.ReadOccurrencesMulti: %v\n", err)
+			return 1
+		}
+
+		v, err := service.ReadOccurrencesMulti(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.ReadOccurrencesMulti: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDReadOccurrencesMulti, ActionIDsReadOccurrencesMulti, StartReadOccurrencesMulti, EndReadOccurrencesMulti)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "compactoccurrences":
+		fsCompactOccurrences.Parse(flag.Args()[1:])
+
+		UserIDCompactOccurrences := *flagUserIDCompactOccurrences
+		ActionIDCompactOccurrences := *flagActionIDCompactOccurrences
+		TimezoneCompactOccurrences := *flagTimezoneCompactOccurrences
+		DryRunCompactOccurrences := *flagDryRunCompactOccurrences
+
+		request, err := handlers.CompactOccurrences(UserIDCompactOccurrences, ActionIDCompactOccurrences, TimezoneCompactOccurrences, DryRunCompactOccurrences)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.CompactOccurrences: %v\n", err)
+			return 1
+		}
+
+		v, err := service.CompactOccurrences(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.CompactOccurrences: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDCompactOccurrences, ActionIDCompactOccurrences, TimezoneCompactOccurrences, DryRunCompactOccurrences)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
+	case "readuserusage":
+		fsReadUserUsage.Parse(flag.Args()[1:])
+
+		UserIDReadUserUsage := *flagUserIDReadUserUsage
+
+		request, err := handlers.ReadUserUsage(UserIDReadUserUsage)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling handlers.ReadUserUsage: %v\n", err)
+			return 1
+		}
+
+		v, err := service.ReadUserUsage(context.Background(), request)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error calling service.ReadUserUsage: %v\n", err)
+			return 1
+		}
+		fmt.Println("Client Requested with:")
+		fmt.Println(UserIDReadUserUsage)
+		fmt.Println("Server Responded with:")
+		fmt.Println(v)
+
 	default:
 		flag.Usage()
 		return 1
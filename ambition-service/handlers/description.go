@@ -0,0 +1,270 @@
+package handlers
+
+import (
+	"html"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// maxDescriptionLength is the most characters an action description may have
+// once sanitized.
+const maxDescriptionLength = 2000
+
+var (
+	// dangerousElements are removed together with their content.
+	dangerousElements = []*regexp.Regexp{
+		regexp.MustCompile(`(?is)<script\b.*?</script\s*>`),
+		regexp.MustCompile(`(?is)<style\b.*?</style\s*>`),
+		regexp.MustCompile(`(?is)<iframe\b.*?</iframe\s*>`),
+		regexp.MustCompile(`(?is)<object\b.*?</object\s*>`),
+		regexp.MustCompile(`(?is)<noscript\b.*?</noscript\s*>`),
+	}
+	htmlComment = regexp.MustCompile(`(?s)<!--.*?-->`)
+	htmlTag     = regexp.MustCompile(`<[/!?]?[a-zA-Z][^<>]*>`)
+	autolink    = regexp.MustCompile(`^<(?i:https?|mailto):[^<>\s"']*>$`)
+	tagStart    = regexp.MustCompile(`<(?:(?i:https?|mailto):[^<>\s"']*>|[/!?a-zA-Z])`)
+
+	// codeFence opens or closes a fenced code block.
+	codeFence = regexp.MustCompile("^ {0,3}(`{3,}|~{3,})")
+	// inlineDestination is the start of an inline link or image destination.
+	inlineDestination = regexp.MustCompile(`\]\(`)
+	// referenceDefinition is the start of a link reference definition, which
+	// may be inside a blockquote or list item.
+	referenceDefinition = regexp.MustCompile(`(?m)^[ \t>]*(?:(?:[*+-]|[0-9]{1,9}[.)])[ \t]+)?[ \t>]*\[(?:\\.|[^\\\]])+\]:`)
+)
+
+// dangerousSchemes are link schemes that run code or embed content when a
+// rendered link is followed.
+var dangerousSchemes = []string{"javascript:", "vbscript:", "data:"}
+
+// sanitizeDescription makes a markdown action description safe for clients to
+// render. Markdown is spaced as written, but raw HTML outside of code is
+// removed, except for http and mailto autolinks. Descriptions with a link or
+// image whose destination has a dangerous scheme are rejected.
+func sanitizeDescription(description string) (string, error) {
+	var out []string
+	for _, part := range splitCode(description) {
+		if part.code {
+			out = append(out, part.text)
+		} else {
+			out = append(out, sanitizeHTML(part.text))
+		}
+	}
+	s := strings.Join(out, "")
+	// Code is checked too, so that text wrongly taken for code is still safe
+	if hasDangerousLink(s) {
+		return "", errors.Errorf("description must not link to the schemes %s", strings.Join(dangerousSchemes, " "))
+	}
+
+	if n := utf8.RuneCountInString(s); n > maxDescriptionLength {
+		return "", errors.Errorf("description must be at most %d characters, got %d", maxDescriptionLength, n)
+	}
+	return s, nil
+}
+
+// sanitizeHTML removes raw HTML from markdown that is not code.
+func sanitizeHTML(s string) string {
+	for _, element := range dangerousElements {
+		s = element.ReplaceAllString(s, "")
+	}
+	s = htmlComment.ReplaceAllString(s, "")
+	s = htmlTag.ReplaceAllStringFunc(s, func(tag string) string {
+		if autolink.MatchString(tag) {
+			return tag
+		}
+		return ""
+	})
+	// Escape whatever is left that could still open a tag, such as one
+	// missing its closing angle bracket
+	return tagStart.ReplaceAllStringFunc(s, func(start string) string {
+		if strings.HasSuffix(start, ">") {
+			return start
+		}
+		return "&lt;" + start[1:]
+	})
+}
+
+// markdownPart is a piece of a markdown document that either is or is not code.
+type markdownPart struct {
+	text string
+	code bool
+}
+
+// splitCode splits markdown into fenced code blocks, inline code spans and
+// the text around them, which joined together are s again. Markdown renders
+// code as written, so its HTML need not be removed. Anything that might not
+// be code to a renderer is left as text.
+func splitCode(s string) []markdownPart {
+	var parts []markdownPart
+	var text []string
+	var fence string
+	var block []string
+	for _, line := range strings.SplitAfter(s, "\n") {
+		m := codeFence.FindStringSubmatch(line)
+		switch {
+		// Only unindented fences are trusted to open a block, an indented one
+		// may belong to a list item or blockquote above. Backtick fences
+		// cannot have backticks after them
+		case fence == "" && m != nil && line[0] != ' ' && !(m[1][0] == '`' && strings.Contains(line[len(m[1]):], "`")):
+			parts = append(parts, splitCodeSpans(strings.Join(text, ""))...)
+			text = nil
+			fence = m[1]
+			block = []string{line}
+		case fence == "":
+			text = append(text, line)
+		default:
+			block = append(block, line)
+			// A closing fence is at least as long as the opening one and made
+			// of the same character
+			if m != nil && m[1][0] == fence[0] && len(m[1]) >= len(fence) {
+				parts = append(parts, markdownPart{strings.Join(block, ""), true})
+				fence, block = "", nil
+			}
+		}
+	}
+	// An unclosed fence runs to the end of the document
+	if fence != "" {
+		parts = append(parts, markdownPart{strings.Join(block, ""), true})
+	}
+	return append(parts, splitCodeSpans(strings.Join(text, ""))...)
+}
+
+// splitCodeSpans splits markdown without code blocks into code spans and the
+// text around them. A code span starts with a run of backticks and ends with
+// the next run of the same length, a run without one is text. A backslash
+// escaped backtick, or one that may be in a link destination or title where
+// backticks are only text, does not start a code span.
+func splitCodeSpans(s string) []markdownPart {
+	var parts []markdownPart
+	start := 0
+	for i := 0; i < len(s); {
+		if s[i] != '`' {
+			i++
+			continue
+		}
+		if escaped(s, i) || inLink(s[start:i]) {
+			i++
+			continue
+		}
+		run := backtickRun(s, i)
+		end := -1
+		for j := i + run; j < len(s); {
+			if s[j] != '`' {
+				j++
+				continue
+			}
+			n := backtickRun(s, j)
+			if n == run {
+				end = j + n
+				break
+			}
+			j += n
+		}
+		if end < 0 {
+			i += run
+			continue
+		}
+		if start < i {
+			parts = append(parts, markdownPart{s[start:i], false})
+		}
+		parts = append(parts, markdownPart{s[i:end], true})
+		start, i = end, end
+	}
+	if start < len(s) {
+		parts = append(parts, markdownPart{s[start:], false})
+	}
+	return parts
+}
+
+// escaped reports whether the character at i in s follows an odd number of
+// backslashes.
+func escaped(s string, i int) bool {
+	n := 0
+	for i-n > 0 && s[i-n-1] == '\\' {
+		n++
+	}
+	return n%2 == 1
+}
+
+// inLink reports whether the end of text may be inside the parentheses of an
+// inline link or on the line of a link reference definition.
+func inLink(text string) bool {
+	if i := strings.LastIndex(text, "]("); i >= 0 && !strings.Contains(text[i:], ")") {
+		return true
+	}
+	line := text[strings.LastIndex(text, "\n")+1:]
+	return referenceDefinition.MatchString(line)
+}
+
+// backtickRun returns how many backticks there are in s from i.
+func backtickRun(s string, i int) int {
+	n := 0
+	for i+n < len(s) && s[i+n] == '`' {
+		n++
+	}
+	return n
+}
+
+// hasDangerousLink reports whether an inline link, image or link reference
+// definition in the markdown s has a destination starting with one of
+// dangerousSchemes. Autolinks are not checked, as every one other than http
+// and mailto is removed with the raw HTML.
+func hasDangerousLink(s string) bool {
+	var starts []int
+	for _, m := range inlineDestination.FindAllStringIndex(s, -1) {
+		starts = append(starts, m[1])
+	}
+	for _, m := range referenceDefinition.FindAllStringIndex(s, -1) {
+		starts = append(starts, m[1])
+	}
+	for _, start := range starts {
+		scheme := normalizeScheme(destination(s[start:]))
+		for _, dangerous := range dangerousSchemes {
+			if strings.HasPrefix(scheme, dangerous) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// destination returns the link destination s starts with, after any
+// whitespace. It is taken generously, past where a renderer would stop, as
+// only how it starts is checked.
+func destination(s string) string {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	if strings.HasPrefix(s, "<") {
+		if end := strings.IndexByte(s, '>'); end >= 0 {
+			return s[1:end]
+		}
+		return s[1:]
+	}
+	if end := strings.IndexAny(s, ")\n"); end >= 0 {
+		return s[:end]
+	}
+	return s
+}
+
+// normalizeScheme undoes what a markdown renderer and browser would to a link
+// destination before reading its scheme. Renderers decode entities and
+// backslash escapes, and browsers ignore whitespace and control characters
+// in schemes.
+func normalizeScheme(s string) string {
+	for {
+		u := html.UnescapeString(s)
+		if u == s {
+			break
+		}
+		s = u
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '\\' || unicode.IsControl(r) || unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, s)
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"testing"
+)
+
+func TestSanitizeDescription(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"markdown", "# Why\n\n*Daily* [runs](https://example.com) `help`", "# Why\n\n*Daily* [runs](https://example.com) `help`"},
+		{"script", "before<script>alert(1)</script>after", "beforeafter"},
+		{"tag", `<img src=x onerror="alert(1)">text`, "text"},
+		{"comment", "a<!-- <b> -->b", "ab"},
+		{"unclosed tag", "a <img src=x", "a &lt;img src=x"},
+		{"autolink", "<https://example.com/a>", "<https://example.com/a>"},
+		{"unsafe autolink", "<javascript:alert(1)>", ""},
+		{"unsafe angle destination", "[x](<javascript:alert(1)>)", "[x]()"},
+		{"less than", "1 < 2", "1 < 2"},
+		{"scheme in word", "metadata: kept", "metadata: kept"},
+		{"scheme in prose", "Track my data: weight and reps", "Track my data: weight and reps"},
+		{"scheme in title", "Big Data: A Revolution", "Big Data: A Revolution"},
+		{"scheme on its own line", "see\njavascript:alert(1)", "see\njavascript:alert(1)"},
+		{"text of removed tag", "<a href=x>javascript:alert(1)</a>", "javascript:alert(1)"},
+		{"safe link named data", "[data: raw](https://example.com/data:raw)", "[data: raw](https://example.com/data:raw)"},
+		{"code span", "Run `<script>` first", "Run `<script>` first"},
+		{"double backtick code span", "``a ` <b>``", "``a ` <b>``"},
+		{"fenced code", "Setup:\n```html\n<script src=x></script>\n```\n<b>done</b>", "Setup:\n```html\n<script src=x></script>\n```\ndone"},
+		{"unclosed fence", "~~~\n<b>", "~~~\n<b>"},
+		{"unclosed code span", "a ` <b>b</b>", "a ` b"},
+		{"escaped backtick", "\\`<script>alert(1)</script>`", "\\``"},
+		{"backtick in destination", "[a](b`c) <script>alert(1)</script> `d)", "[a](b`c)  `d)"},
+		{"backtick in title", "[a](b \"x`\") <b>y</b> `\"", "[a](b \"x`\") y `\""},
+		{"backtick in reference", "[a]: /b \"`\"\n<b>y</b>`", "[a]: /b \"`\"\ny`"},
+		{"indented fence", "- a\n  ```\n<b>y</b>\n", "- a\n  ```\ny\n"},
+		{"backtick fence with backtick", "```a`\n<b>y</b>\n```", "```a`\ny\n```"},
+	}
+	for _, c := range cases {
+		got, err := sanitizeDescription(c.in)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("%s: got %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestSanitizeDescriptionRejectsDangerousSchemes(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+	}{
+		{"inline link", "[x](javascript:alert(1))"},
+		{"image", "![x](data:text/html,<b>)"},
+		{"vbscript", "[x](vbscript:msgbox)"},
+		{"upper case", "[x](JavaScript:alert(1))"},
+		{"entity", "[x](&#106;avascript:alert(1))"},
+		{"double entity", "[x](&amp;#106;avascript:alert(1))"},
+		{"named entity", "[x](javascript&colon;alert(1))"},
+		{"backslash escape", `[x](javascript\:alert(1))`},
+		{"tab in scheme", "[x](java\tscript:alert(1))"},
+		{"entity tab in scheme", "[x](java&Tab;script:alert(1))"},
+		{"reference", "[go]\n\n[go]: javascript:alert(1)"},
+		{"reference next line", "[go]\n\n[go]:\n  javascript:alert(1)"},
+		{"reference escaped label", "[a\\]b]\n\n[a\\]b]: javascript:alert(1)"},
+		{"reference in blockquote", "> [r]: javascript:alert(1)\n\n[r]"},
+		{"reference in list", "- [r]: javascript:alert(1)"},
+		{"leading whitespace", "[x](  javascript:alert(1))"},
+		{"angle destination", "[x](< javascript:alert(1)>)"},
+		{"destination on next line", "[x](\njavascript:alert(1))"},
+		{"link text in code", "[`x`](javascript:alert(1))"},
+		{"inside code span", "`[x](javascript:alert(1))`"},
+		{"tag split scheme", "[x](java<b>script:alert(1))"},
+	}
+	for _, c := range cases {
+		got, err := sanitizeDescription(c.in)
+		if err == nil {
+			t.Errorf("%s: %q was not rejected, got %q", c.name, c.in, got)
+		}
+	}
+}
+
+func TestSanitizeDescriptionLength(t *testing.T) {
+	long := make([]rune, maxDescriptionLength+1)
+	for i := range long {
+		long[i] = 'é'
+	}
+	if _, err := sanitizeDescription(string(long[:maxDescriptionLength])); err != nil {
+		t.Errorf("description of %d characters was rejected: %v", maxDescriptionLength, err)
+	}
+	if _, err := sanitizeDescription(string(long)); err == nil {
+		t.Errorf("description of %d characters was not rejected", len(long))
+	}
+}
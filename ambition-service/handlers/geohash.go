@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// defaultGeohashPrecision is the geohash length used when GEOHASH_PRECISION
+// is unset. Five characters is an area of about 4.9km by 4.9km.
+const defaultGeohashPrecision = 5
+
+// maxGeohashPrecision keeps stored geohashes coarse. Six characters is an area
+// of about 1.2km by 0.6km, anything longer starts to pinpoint a building.
+const maxGeohashPrecision = 6
+
+// geohashAlphabet is the base32 alphabet geohashes are written in.
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// geohashPrecisionFromENV reads GEOHASH_PRECISION, the number of characters
+// occurrence geohashes are stored with.
+func geohashPrecisionFromENV() (int, error) {
+	v := os.Getenv("GEOHASH_PRECISION")
+	if v == "" {
+		return defaultGeohashPrecision, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 1 || n > maxGeohashPrecision {
+		return 0, errors.Errorf("GEOHASH_PRECISION must be between 1 and %d, got %q", maxGeohashPrecision, v)
+	}
+	return n, nil
+}
+
+// geohash encodes latitude and longitude as a geohash of precision
+// characters.
+func geohash(latitude, longitude float64, precision int) string {
+	latMin, latMax := -90.0, 90.0
+	lngMin, lngMax := -180.0, 180.0
+
+	hash := make([]byte, 0, precision)
+	var ch, bit int
+	even := true
+	for len(hash) < precision {
+		// Bits alternate between longitude and latitude, starting with longitude
+		if even {
+			mid := (lngMin + lngMax) / 2
+			if longitude >= mid {
+				ch |= 1 << uint(4-bit)
+				lngMin = mid
+			} else {
+				lngMax = mid
+			}
+		} else {
+			mid := (latMin + latMax) / 2
+			if latitude >= mid {
+				ch |= 1 << uint(4-bit)
+				latMin = mid
+			} else {
+				latMax = mid
+			}
+		}
+		even = !even
+
+		if bit < 4 {
+			bit++
+			continue
+		}
+		hash = append(hash, geohashAlphabet[ch])
+		ch, bit = 0, 0
+	}
+	return string(hash)
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"os"
+	"testing"
+)
+
+func TestGeohash(t *testing.T) {
+	cases := []struct {
+		latitude, longitude float64
+		precision           int
+		want                string
+	}{
+		{57.64911, 10.40744, 6, "u4pruy"},
+		{57.64911, 10.40744, 3, "u4p"},
+		{42.6, -5.6, 5, "ezs42"},
+		{-25.382708, -49.265506, 5, "6gkzw"},
+		{0, 0, 1, "s"},
+	}
+	for _, c := range cases {
+		if got := geohash(c.latitude, c.longitude, c.precision); got != c.want {
+			t.Errorf("geohash(%v, %v, %d) = %q, want %q", c.latitude, c.longitude, c.precision, got, c.want)
+		}
+	}
+}
+
+func TestGeohashPrecisionFromENV(t *testing.T) {
+	defer os.Unsetenv("GEOHASH_PRECISION")
+	cases := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{"", defaultGeohashPrecision, false},
+		{"3", 3, false},
+		{"6", 6, false},
+		{"7", 0, true},
+		{"0", 0, true},
+		{"five", 0, true},
+	}
+	for _, c := range cases {
+		os.Setenv("GEOHASH_PRECISION", c.value)
+		got, err := geohashPrecisionFromENV()
+		if (err != nil) != c.wantErr || got != c.want {
+			t.Errorf("GEOHASH_PRECISION=%q: got %d, %v", c.value, got, err)
+		}
+	}
+}
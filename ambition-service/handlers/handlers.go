@@ -1,11 +1,14 @@
 package handlers
 
 import (
-	"fmt"
 	"golang.org/x/net/context"
-	//"os"
+	"os"
+	"sort"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/go-kit/kit/log"
 	"github.com/pkg/errors"
 
 	pb "github.com/adamryman/ambition-model/ambition-service"
@@ -14,11 +17,33 @@ import (
 	"github.com/adamryman/kit/dbconn"
 )
 
+// maxReconcileIDs caps how many occurrence IDs a client may send to
+// ReconcileOccurrences in a single request.
+const maxReconcileIDs = 10000
+
+// maxMultiActions caps how many actions ReadOccurrencesMulti reads at once.
+const maxMultiActions = 50
+
+// maxOccurrenceDataLength is the size of occurrences.data.
+const maxOccurrenceDataLength = 255
+
+// minRating and maxRating bound Occurrence.Rating. A Rating of zero means the
+// occurrence is unrated.
+const (
+	minRating = 1
+	maxRating = 5
+)
+
 // NewService returns a naïve, stateless implementation of Service.
 func NewService() pb.AmbitionServer {
-	//database, err := sql.Open(os.Getenv("SQLITE3"))
+	caseSensitiveNames, err := caseSensitiveNamesFromENV()
+	if err != nil {
+		panic(err)
+	}
+
+	//database, err := sql.Open(os.Getenv("SQLITE3"), caseSensitiveNames)
 
-	database, err := sql.Open(dbconn.FromENV("MYSQL").MySQL())
+	database, err := sql.Open(dbconn.FromENV("MYSQL").MySQL(), caseSensitiveNames)
 	if err != nil {
 		// TODO: Do not panic, start something to try connection over and over.
 		// Maybe 100 times?
@@ -26,19 +51,60 @@ func NewService() pb.AmbitionServer {
 		// There will also need to be retry logic for the database methods
 		panic(err)
 	}
+	timestamps, err := timeParserFromENV()
+	if err != nil {
+		panic(err)
+	}
+	maxBackfillAge, err := maxBackfillAgeFromENV()
+	if err != nil {
+		panic(err)
+	}
+	geohashPrecision, err := geohashPrecisionFromENV()
+	if err != nil {
+		panic(err)
+	}
+	logger := log.NewLogfmtLogger(os.Stdout)
+	logger = log.NewContext(logger).With("ts", log.DefaultTimestampUTC)
 	return ambitionService{
-		db: database,
+		db:               database,
+		timestamps:       timestamps,
+		maxBackfillAge:   maxBackfillAge,
+		geohashPrecision: geohashPrecision,
+		logger:           logger,
 	}
 }
 
 type ambitionService struct {
-	db *sql.Database
+	db               store
+	timestamps       timeParser
+	maxBackfillAge   time.Duration
+	geohashPrecision int
+	logger           log.Logger
+}
+
+// skipOccurrence logs that o is left out of a response because its stored
+// Datetime, which failed to parse with err, cannot be placed in time.
+func (s ambitionService) skipOccurrence(o *pb.Occurrence, err error) {
+	s.logger.Log("msg", "skipping occurrence with unparsable datetime", "occurrence", o.GetID(), "datetime", o.GetDatetime(), "err", err)
 }
 
 // CreateAction implements Service.
 func (s ambitionService) CreateAction(ctx context.Context, in *pb.Action) (*pb.Action, error) {
 	// TODO: Input validation
+	description, err := sanitizeDescription(in.GetDescription())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create action")
+	}
+	in.Description = description
+	in.Reminder, err = normalizeReminder(in.GetReminder())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create action")
+	}
+
 	a, err := s.db.CreateAction(in)
+	if err == sql.ErrActionExists {
+		return nil, errors.Errorf("cannot create action, user already has an action named %q", in.GetName())
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create action")
 	}
@@ -47,41 +113,108 @@ func (s ambitionService) CreateAction(ctx context.Context, in *pb.Action) (*pb.A
 
 // CreateOccurrence implements Service.
 func (s ambitionService) CreateOccurrence(ctx context.Context, in *pb.CreateOccurrenceRequest) (*pb.Occurrence, error) {
-	// TODO: Make sure database accepts this time format
 	utc7, err := time.LoadLocation("America/Los_Angeles")
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create time location UTC-7")
 	}
-	nowutc := time.Now()
-	fmt.Println(nowutc)
-	now := nowutc.In(utc7).String()
-	fmt.Println(now)
 
 	occurrence := in.GetOccurrence()
 	if occurrence == nil {
 		return nil, errors.New("cannot create nil occurrence")
 	}
-	if occurrence.GetDatetime() == "" {
-		occurrence.Datetime = now
+	if r := occurrence.GetRating(); r != 0 && (r < minRating || r > maxRating) {
+		return nil, errors.Errorf("cannot create occurrence, Rating must be between %d and %d", minRating, maxRating)
+	}
+	now := time.Now()
+	datetime := now
+	if occurrence.GetDatetime() != "" {
+		datetime, err = s.timestamps.Parse(occurrence.GetDatetime())
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot create occurrence")
+		}
+	}
+	if s.maxBackfillAge > 0 && datetime.Before(now.Add(-s.maxBackfillAge)) {
+		return nil, errors.Errorf("cannot create occurrence more than %s in the past", s.maxBackfillAge)
 	}
+	occurrence.Datetime = datetime.In(utc7).Format(datetimeLayout)
 
-	action, err := s.db.ReadActionByID(occurrence.GetActionID())
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot read action")
+	// Only the server derives geohashes, and the location itself is never stored
+	occurrence.Geohash = ""
+	if loc := in.GetLocation(); loc != nil {
+		lat, lng := loc.GetLatitude(), loc.GetLongitude()
+		if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+			return nil, errors.New("cannot create occurrence, Location must have a Latitude between -90 and 90 and a Longitude between -180 and 180")
+		}
+		occurrence.Geohash = geohash(lat, lng, s.geohashPrecision)
+	}
+
+	var action *pb.Action
+	if occurrence.GetActionID() == 0 && in.GetActionName() != "" {
+		action, err = s.db.ReadActionByNameAndUserID(in.GetActionName(), in.GetUserID())
+		if err == sql.ErrActionNotFound && in.GetAutoCreateAction() {
+			created := &pb.Action{Name: in.GetActionName(), UserID: in.GetUserID()}
+			_, o, err := s.db.CreateActionWithOccurrence(created, occurrence)
+			if err == nil {
+				return o, nil
+			}
+			if err != sql.ErrActionExists {
+				return nil, errors.Wrap(err, "cannot create occurrence")
+			}
+			// Another request created the action first, so log against it
+			action, err = s.db.ReadActionByNameAndUserID(in.GetActionName(), in.GetUserID())
+		}
+		if err == sql.ErrActionNotFound {
+			return nil, errors.Errorf("cannot create occurrence, user has no action named %q", in.GetActionName())
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot read action")
+		}
+		occurrence.ActionID = action.GetID()
+	} else {
+		action, err = s.db.ReadActionByID(occurrence.GetActionID())
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot read action")
+		}
 	}
 	if action.GetUserID() != in.GetUserID() {
-		// TODO: Replace this "logging" with real logging
-		fmt.Printf("action-user-id: %d\nuser-id:%d\n", action.GetUserID(), in.GetUserID())
+		s.logger.Log("msg", "rejected occurrence for action not owned by user", "action", action.GetID(), "action_user", action.GetUserID(), "user", in.GetUserID())
 		return nil, errors.New("cannot create occurrence for action not owned by user")
 	}
 
-	o, err := s.db.CreateOccurrence(occurrence)
+	var o *pb.Occurrence
+	if action.GetMonotonic() {
+		o, err = s.db.CreateOccurrenceChecked(occurrence, func(existing []*pb.Occurrence) error {
+			return s.checkMonotonic(existing, datetime)
+		})
+	} else {
+		o, err = s.db.CreateOccurrence(occurrence)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot create occurrence")
 	}
 	return o, nil
 }
 
+// checkMonotonic returns an error unless datetime is later than every one of
+// occurrences.
+func (s ambitionService) checkMonotonic(occurrences []*pb.Occurrence, datetime time.Time) error {
+	var latest time.Time
+	for _, o := range occurrences {
+		at, err := parseStoredDatetime(o.GetDatetime())
+		if err != nil {
+			s.skipOccurrence(o, err)
+			continue
+		}
+		if at.After(latest) {
+			latest = at
+		}
+	}
+	if !latest.IsZero() && !datetime.After(latest) {
+		return errors.Errorf("action is monotonic and Datetime must be after its latest occurrence at %s", latest.Format(time.RFC3339Nano))
+	}
+	return nil
+}
+
 // ReadAction implements Service.
 func (s ambitionService) ReadAction(ctx context.Context, in *pb.Action) (*pb.Action, error) {
 	if in.GetID() != 0 {
@@ -103,12 +236,27 @@ func (s ambitionService) ReadAction(ctx context.Context, in *pb.Action) (*pb.Act
 }
 
 // ReadActions implements Service.
-// TODO: Implement
-func (s ambitionService) ReadActions(ctx context.Context, in *pb.User) (*pb.ActionsResponse, error) {
-	// TODO: Input validation
-	var resp pb.ActionsResponse
-	resp = pb.ActionsResponse{
-	// Actions:
+func (s ambitionService) ReadActions(ctx context.Context, in *pb.ReadActionsRequest) (*pb.ActionsResponse, error) {
+	if in.GetUserID() == 0 {
+		return nil, errors.New("cannot read actions, need UserID")
+	}
+	if in.GetMinOccurrences() < 0 {
+		return nil, errors.New("cannot read actions, MinOccurrences cannot be negative")
+	}
+	var byRecency bool
+	switch in.GetOrderBy() {
+	case "":
+	case "recency":
+		byRecency = true
+	default:
+		return nil, errors.Errorf("cannot read actions, unknown OrderBy %q", in.GetOrderBy())
+	}
+	actions, err := s.db.ReadActions(in.GetUserID(), in.GetMinOccurrences(), byRecency)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read actions")
+	}
+	resp := pb.ActionsResponse{
+		Actions: actions,
 	}
 	return &resp, nil
 }
@@ -135,3 +283,564 @@ func (s ambitionService) ReadOccurrencesByDate(ctx context.Context, in *pb.Occur
 	}
 	return &resp, nil
 }
+
+// ReconcileOccurrences implements Service.
+func (s ambitionService) ReconcileOccurrences(ctx context.Context, in *pb.ReconcileOccurrencesRequest) (*pb.ReconcileOccurrencesResponse, error) {
+	if in.GetUserID() == 0 || in.GetActionID() == 0 {
+		return nil, errors.New("cannot reconcile occurrences, need BOTH UserID and ActionID")
+	}
+	if len(in.GetOccurrenceIDs()) > maxReconcileIDs {
+		return nil, errors.Errorf("cannot reconcile more than %d occurrence ids at once", maxReconcileIDs)
+	}
+
+	action, err := s.db.ReadActionByID(in.GetActionID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read action")
+	}
+	if action.GetUserID() != in.GetUserID() {
+		return nil, errors.New("cannot reconcile occurrences for action not owned by user")
+	}
+
+	serverIDs, err := s.db.ReadOccurrenceIDsByActionID(in.GetActionID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read occurrence ids")
+	}
+
+	client := make(map[int64]bool, len(in.GetOccurrenceIDs()))
+	for _, id := range in.GetOccurrenceIDs() {
+		client[id] = true
+	}
+	server := make(map[int64]bool, len(serverIDs))
+	for _, id := range serverIDs {
+		server[id] = true
+	}
+
+	var resp pb.ReconcileOccurrencesResponse
+	for _, id := range serverIDs {
+		if !client[id] {
+			resp.ServerOnly = append(resp.ServerOnly, id)
+		}
+	}
+	for _, id := range in.GetOccurrenceIDs() {
+		if !server[id] {
+			resp.ClientOnly = append(resp.ClientOnly, id)
+			// Only report duplicated client ids once
+			server[id] = true
+		}
+	}
+	return &resp, nil
+}
+
+// TouchAction implements Service.
+func (s ambitionService) TouchAction(ctx context.Context, in *pb.Action) (*pb.Action, error) {
+	if in.GetID() == 0 || in.GetUserID() == 0 {
+		return nil, errors.New("cannot touch action, need BOTH ID and UserID")
+	}
+	action, err := s.db.ReadActionByID(in.GetID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read action")
+	}
+	if action.GetUserID() != in.GetUserID() {
+		return nil, errors.New("cannot touch action not owned by user")
+	}
+
+	now := time.Now().UnixNano()
+	if err := s.db.TouchAction(action.GetID(), now); err != nil {
+		return nil, errors.Wrap(err, "cannot touch action")
+	}
+	action.LastTouched = now
+
+	return action, nil
+}
+
+// CloneActions implements Service.
+func (s ambitionService) CloneActions(ctx context.Context, in *pb.CloneActionsRequest) (*pb.ActionsResponse, error) {
+	if in.GetUserID() == 0 || in.GetSourceUserID() == 0 {
+		return nil, errors.New("cannot clone actions, need BOTH UserID and SourceUserID")
+	}
+	if in.GetUserID() == in.GetSourceUserID() {
+		return nil, errors.New("cannot clone actions from a user to themselves")
+	}
+	actions, err := s.db.CloneActions(in.GetSourceUserID(), in.GetUserID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot clone actions")
+	}
+	resp := pb.ActionsResponse{
+		Actions: actions,
+	}
+	return &resp, nil
+}
+
+// ReadFirstOccurrence implements Service.
+func (s ambitionService) ReadFirstOccurrence(ctx context.Context, in *pb.User) (*pb.Occurrence, error) {
+	if in.GetUserID() == 0 {
+		return nil, errors.New("cannot read first occurrence, need UserID")
+	}
+	occurrences, err := s.db.ReadOccurrencesByUserID(in.GetUserID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read occurrences")
+	}
+
+	// Datetimes are stored as strings with varying offsets, so they are
+	// compared in Go rather than with MIN
+	first := &pb.Occurrence{}
+	var firstAt time.Time
+	for _, o := range occurrences {
+		at, err := parseStoredDatetime(o.GetDatetime())
+		if err != nil {
+			s.skipOccurrence(o, err)
+			continue
+		}
+		if first.GetID() == 0 || at.Before(firstAt) || (at.Equal(firstAt) && o.GetID() < first.GetID()) {
+			first, firstAt = o, at
+		}
+	}
+	return first, nil
+}
+
+// ReadDaySummary implements Service.
+func (s ambitionService) ReadDaySummary(ctx context.Context, in *pb.DaySummaryRequest) (*pb.DaySummaryResponse, error) {
+	if in.GetUserID() == 0 || in.GetDate() == "" || in.GetTimezone() == "" {
+		return nil, errors.New("cannot read day summary, need UserID, Date and Timezone")
+	}
+	loc, err := time.LoadLocation(in.GetTimezone())
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read day summary, unknown Timezone %q", in.GetTimezone())
+	}
+	start, err := time.ParseInLocation(dateLayout, in.GetDate(), loc)
+	if err != nil {
+		return nil, errors.Errorf("cannot read day summary, Date must be YYYY-MM-DD, got %q", in.GetDate())
+	}
+	// AddDate keeps the bounds at local midnight across daylight saving changes
+	end := start.AddDate(0, 0, 1)
+
+	actions, err := s.db.ReadActions(in.GetUserID(), 0, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read actions")
+	}
+	occurrences, err := s.db.ReadOccurrencesByUserID(in.GetUserID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read occurrences")
+	}
+
+	var onDay timedOccurrences
+	for _, o := range occurrences {
+		at, err := parseStoredDatetime(o.GetDatetime())
+		if err != nil {
+			s.skipOccurrence(o, err)
+			continue
+		}
+		if at.Before(start) || !at.Before(end) {
+			continue
+		}
+		onDay = append(onDay, timedOccurrence{o, at})
+	}
+	sort.Sort(onDay)
+
+	byAction := make(map[int64]*pb.ActionDaySummary)
+	for _, o := range onDay {
+		summary := byAction[o.GetActionID()]
+		if summary == nil {
+			summary = &pb.ActionDaySummary{}
+			byAction[o.GetActionID()] = summary
+		}
+		summary.Count++
+		summary.Occurrences = append(summary.Occurrences, o.Occurrence)
+	}
+
+	var resp pb.DaySummaryResponse
+	for _, a := range actions {
+		if summary := byAction[a.GetID()]; summary != nil {
+			summary.Action = a
+			summary.AverageRating = averageRating(summary.Occurrences)
+			resp.Actions = append(resp.Actions, summary)
+		}
+	}
+	sort.Sort(summariesByActionID(resp.Actions))
+	return &resp, nil
+}
+
+// summariesByActionID sorts action day summaries by action ID.
+type summariesByActionID []*pb.ActionDaySummary
+
+func (s summariesByActionID) Len() int           { return len(s) }
+func (s summariesByActionID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s summariesByActionID) Less(i, j int) bool { return s[i].Action.GetID() < s[j].Action.GetID() }
+
+// timedOccurrence is an occurrence with its parsed datetime.
+type timedOccurrence struct {
+	*pb.Occurrence
+	at time.Time
+}
+
+// timedOccurrences sorts occurrences by time and then by ID.
+type timedOccurrences []timedOccurrence
+
+func (t timedOccurrences) Len() int      { return len(t) }
+func (t timedOccurrences) Swap(i, j int) { t[i], t[j] = t[j], t[i] }
+func (t timedOccurrences) Less(i, j int) bool {
+	if !t[i].at.Equal(t[j].at) {
+		return t[i].at.Before(t[j].at)
+	}
+	return t[i].GetID() < t[j].GetID()
+}
+
+// ShiftOccurrences implements Service.
+func (s ambitionService) ShiftOccurrences(ctx context.Context, in *pb.ShiftOccurrencesRequest) (*pb.ShiftOccurrencesResponse, error) {
+	if in.GetUserID() == 0 || in.GetOffset() == "" {
+		return nil, errors.New("cannot shift occurrences, need BOTH UserID and Offset")
+	}
+	if !in.GetConfirm() {
+		return nil, errors.New("cannot shift occurrences without Confirm")
+	}
+	offset, err := time.ParseDuration(in.GetOffset())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot shift occurrences, invalid Offset")
+	}
+	utc7, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create time location UTC-7")
+	}
+
+	if in.GetActionID() != 0 {
+		action, err := s.db.ReadActionByID(in.GetActionID())
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot read action")
+		}
+		if action.GetUserID() != in.GetUserID() {
+			return nil, errors.New("cannot shift occurrences for action not owned by user")
+		}
+	}
+	if offset == 0 {
+		return &pb.ShiftOccurrencesResponse{}, nil
+	}
+
+	var shifted int64
+	err = s.db.ShiftOccurrenceDatetimes(in.GetUserID(), in.GetActionID(), func(occurrences []*pb.Occurrence) (map[int64]string, error) {
+		now := time.Now()
+		datetimes := make(map[int64]string, len(occurrences))
+		for _, o := range occurrences {
+			at, err := parseStoredDatetime(o.GetDatetime())
+			if err != nil {
+				return nil, errors.Wrapf(err, "cannot shift occurrence %d", o.GetID())
+			}
+			at = at.Add(offset)
+			if at.After(now) {
+				return nil, errors.Errorf("occurrence %d would be in the future", o.GetID())
+			}
+			if offset < 0 && s.maxBackfillAge > 0 && at.Before(now.Add(-s.maxBackfillAge)) {
+				return nil, errors.Errorf("occurrence %d would be more than %s in the past", o.GetID(), s.maxBackfillAge)
+			}
+			datetimes[o.GetID()] = at.In(utc7).Format(datetimeLayout)
+		}
+		shifted = int64(len(datetimes))
+		return datetimes, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot shift occurrences")
+	}
+
+	resp := pb.ShiftOccurrencesResponse{
+		Shifted: shifted,
+	}
+	return &resp, nil
+}
+
+// TransferAction implements Service.
+func (s ambitionService) TransferAction(ctx context.Context, in *pb.TransferActionRequest) (*pb.Action, error) {
+	if in.GetUserID() == 0 || in.GetActionID() == 0 || in.GetNewUserID() == 0 {
+		return nil, errors.New("cannot transfer action, need UserID, ActionID and NewUserID")
+	}
+	if in.GetUserID() == in.GetNewUserID() {
+		return nil, errors.New("cannot transfer action to the user that already owns it")
+	}
+	action, err := s.db.ReadActionByID(in.GetActionID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read action")
+	}
+	if action.GetUserID() != in.GetUserID() {
+		return nil, errors.New("cannot transfer action not owned by user")
+	}
+
+	// The store checks ownership again as it moves the action, in case it was
+	// transferred since it was read
+	err = s.db.TransferAction(action.GetID(), in.GetUserID(), in.GetNewUserID())
+	if err == sql.ErrActionNotOwned {
+		return nil, errors.New("cannot transfer action not owned by user")
+	}
+	if err == sql.ErrActionExists {
+		return nil, errors.Errorf("cannot transfer action, new user already has an action named %q", action.GetName())
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot transfer action")
+	}
+	action.UserID = in.GetNewUserID()
+
+	return action, nil
+}
+
+// ReadUnloggedActions implements Service.
+func (s ambitionService) ReadUnloggedActions(ctx context.Context, in *pb.ReadUnloggedActionsRequest) (*pb.ActionsResponse, error) {
+	if in.GetUserID() == 0 {
+		return nil, errors.New("cannot read unlogged actions, need UserID")
+	}
+	if in.GetMinAgeDays() < 0 {
+		return nil, errors.New("cannot read unlogged actions, MinAgeDays cannot be negative")
+	}
+	var createdBefore int64
+	if days := in.GetMinAgeDays(); days > 0 {
+		now := time.Now()
+		// Only actions from before creation times were recorded, stored as
+		// created at zero, are older than the unix epoch
+		createdBefore = 1
+		if days < now.Unix()/(24*60*60) {
+			createdBefore = now.AddDate(0, 0, -int(days)).UnixNano()
+		}
+	}
+	actions, err := s.db.ReadUnloggedActions(in.GetUserID(), createdBefore)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read unlogged actions")
+	}
+	resp := pb.ActionsResponse{
+		Actions: actions,
+	}
+	return &resp, nil
+}
+
+// ReadDueReminders implements Service.
+func (s ambitionService) ReadDueReminders(ctx context.Context, in *pb.DueRemindersRequest) (*pb.ActionsResponse, error) {
+	if in.GetUserID() == 0 {
+		return nil, errors.New("cannot read due reminders, need UserID")
+	}
+	at := time.Now()
+	if in.GetTime() != "" {
+		var err error
+		at, err = s.timestamps.Parse(in.GetTime())
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot read due reminders")
+		}
+	}
+
+	actions, err := s.db.ReadActions(in.GetUserID(), 0, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read actions")
+	}
+	var resp pb.ActionsResponse
+	for _, a := range actions {
+		if reminderDue(a.GetReminder(), at) {
+			resp.Actions = append(resp.Actions, a)
+		}
+	}
+	return &resp, nil
+}
+
+// ReadOccurrencesMulti implements Service.
+func (s ambitionService) ReadOccurrencesMulti(ctx context.Context, in *pb.OccurrencesMultiRequest) (*pb.OccurrencesMultiResponse, error) {
+	if in.GetUserID() == 0 || len(in.GetActionIDs()) == 0 {
+		return nil, errors.New("cannot read occurrences, need BOTH UserID and ActionIDs")
+	}
+	if len(in.GetActionIDs()) > maxMultiActions {
+		return nil, errors.Errorf("cannot read occurrences of more than %d actions at once", maxMultiActions)
+	}
+	var start, end time.Time
+	var err error
+	if in.GetStart() != "" {
+		if start, err = s.timestamps.Parse(in.GetStart()); err != nil {
+			return nil, errors.Wrap(err, "cannot read occurrences, invalid Start")
+		}
+	}
+	if in.GetEnd() != "" {
+		if end, err = s.timestamps.Parse(in.GetEnd()); err != nil {
+			return nil, errors.Wrap(err, "cannot read occurrences, invalid End")
+		}
+	}
+
+	var resp pb.OccurrencesMultiResponse
+	var ids []int64
+	byAction := make(map[int64]*pb.ActionOccurrences, len(in.GetActionIDs()))
+	for _, id := range in.GetActionIDs() {
+		if byAction[id] != nil {
+			continue
+		}
+		byAction[id] = &pb.ActionOccurrences{ActionID: id}
+		resp.Actions = append(resp.Actions, byAction[id])
+		ids = append(ids, id)
+	}
+
+	actions, err := s.db.ReadActionsByIDs(ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read actions")
+	}
+	owned := make(map[int64]bool, len(actions))
+	for _, a := range actions {
+		owned[a.GetID()] = a.GetUserID() == in.GetUserID()
+	}
+	for _, id := range ids {
+		if !owned[id] {
+			return nil, errors.Errorf("cannot read occurrences for action %d not owned by user", id)
+		}
+	}
+
+	// Only the datetime range is applied in Go, as datetimes are stored as
+	// strings with varying offsets
+	occurrences, err := s.db.ReadOccurrencesByActionIDs(ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read occurrences")
+	}
+	var inRange timedOccurrences
+	for _, o := range occurrences {
+		at, err := parseStoredDatetime(o.GetDatetime())
+		if err != nil {
+			s.skipOccurrence(o, err)
+			continue
+		}
+		if (!start.IsZero() && at.Before(start)) || (!end.IsZero() && !at.Before(end)) {
+			continue
+		}
+		inRange = append(inRange, timedOccurrence{o, at})
+	}
+	sort.Sort(inRange)
+	for _, o := range inRange {
+		group := byAction[o.GetActionID()]
+		group.Occurrences = append(group.Occurrences, o.Occurrence)
+	}
+	for _, group := range resp.Actions {
+		group.AverageRating = averageRating(group.Occurrences)
+	}
+
+	return &resp, nil
+}
+
+// averageRating returns the mean Rating of the rated occurrences, zero if
+// none are rated.
+func averageRating(occurrences []*pb.Occurrence) float64 {
+	var sum, rated int64
+	for _, o := range occurrences {
+		if o.GetRating() != 0 {
+			sum += o.GetRating()
+			rated++
+		}
+	}
+	if rated == 0 {
+		return 0
+	}
+	return float64(sum) / float64(rated)
+}
+
+// CompactOccurrences implements Service.
+func (s ambitionService) CompactOccurrences(ctx context.Context, in *pb.CompactOccurrencesRequest) (*pb.CompactOccurrencesResponse, error) {
+	if in.GetUserID() == 0 || in.GetActionID() == 0 || in.GetTimezone() == "" {
+		return nil, errors.New("cannot compact occurrences, need UserID, ActionID and Timezone")
+	}
+	loc, err := time.LoadLocation(in.GetTimezone())
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot compact occurrences, unknown Timezone %q", in.GetTimezone())
+	}
+	action, err := s.db.ReadActionByID(in.GetActionID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read action")
+	}
+	if action.GetUserID() != in.GetUserID() {
+		return nil, errors.New("cannot compact occurrences for action not owned by user")
+	}
+
+	occurrences, err := s.db.ReadOccurrencesByActionID(action.GetID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read occurrences")
+	}
+	var timed timedOccurrences
+	for _, o := range occurrences {
+		at, err := parseStoredDatetime(o.GetDatetime())
+		if err != nil {
+			// Leave what cannot be placed on a day alone
+			s.skipOccurrence(o, err)
+			continue
+		}
+		timed = append(timed, timedOccurrence{o, at})
+	}
+	sort.Sort(timed)
+
+	// The first occurrence seen on each day is the earliest and is kept
+	var days [][]*pb.Occurrence
+	dayIndex := make(map[string]int)
+	for _, o := range timed {
+		day := o.at.In(loc).Format(dateLayout)
+		i, ok := dayIndex[day]
+		if !ok {
+			i = len(days)
+			dayIndex[day] = i
+			days = append(days, nil)
+		}
+		days[i] = append(days[i], o.Occurrence)
+	}
+	var kept []*pb.Occurrence
+	var merged []int64
+	var skipped int64
+	for _, day := range days {
+		if len(day) < 2 {
+			continue
+		}
+		o, ok := mergeOccurrences(day)
+		if !ok {
+			skipped++
+			continue
+		}
+		kept = append(kept, o)
+		for _, m := range day[1:] {
+			merged = append(merged, m.GetID())
+		}
+	}
+	if !in.GetDryRun() && len(merged) > 0 {
+		if err := s.db.MergeOccurrences(kept, merged); err != nil {
+			return nil, errors.Wrap(err, "cannot compact occurrences")
+		}
+	}
+
+	resp := pb.CompactOccurrencesResponse{
+		Merged:  int64(len(merged)),
+		Skipped: skipped,
+	}
+	return &resp, nil
+}
+
+// mergeOccurrences returns the first of occurrences with the Data of all of
+// them, one per line, and the Rating any of them has. It returns false if
+// they have different Ratings or their Data does not fit in one occurrence.
+func mergeOccurrences(occurrences []*pb.Occurrence) (*pb.Occurrence, bool) {
+	first := *occurrences[0]
+	var data []string
+	for _, o := range occurrences {
+		if o.GetData() != "" {
+			data = append(data, o.GetData())
+		}
+		if r := o.GetRating(); r != 0 {
+			if first.Rating != 0 && first.Rating != r {
+				return nil, false
+			}
+			first.Rating = r
+		}
+	}
+	first.Data = strings.Join(data, "\n")
+	if utf8.RuneCountInString(first.Data) > maxOccurrenceDataLength {
+		return nil, false
+	}
+	return &first, true
+}
+
+// ReadUserUsage implements Service.
+func (s ambitionService) ReadUserUsage(ctx context.Context, in *pb.User) (*pb.UserUsage, error) {
+	if in.GetUserID() == 0 {
+		return nil, errors.New("cannot read usage, need UserID")
+	}
+	actions, occurrences, dataBytes, err := s.db.ReadUserUsage(in.GetUserID())
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read usage")
+	}
+
+	resp := pb.UserUsage{
+		Actions:     actions,
+		Occurrences: occurrences,
+		DataBytes:   dataBytes,
+	}
+	return &resp, nil
+}
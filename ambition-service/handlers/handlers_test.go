@@ -0,0 +1,971 @@
+package handlers
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"golang.org/x/net/context"
+
+	pb "github.com/adamryman/ambition-model/ambition-service"
+	sqlite "github.com/adamryman/ambition-model/sqlite"
+)
+
+// newTestService returns a service backed by a new sqlite database file, and
+// a func that removes it.
+func newTestService(t *testing.T) (ambitionService, func()) {
+	dir, err := ioutil.TempDir("", "ambition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := sqlite.Open(filepath.Join(dir, "ambition.db"), false)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	s := ambitionService{db: d, logger: log.NewNopLogger()}
+	return s, func() {
+		d.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// createAction creates an action named name for userID in s.
+func createAction(t *testing.T, s ambitionService, name string, userID int64) *pb.Action {
+	a, err := s.db.CreateAction(&pb.Action{Name: name, UserID: userID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// createOccurrence stores an occurrence of the action with actionID at.
+func createOccurrence(t *testing.T, s ambitionService, actionID int64, at time.Time) *pb.Occurrence {
+	o, err := s.db.CreateOccurrence(&pb.Occurrence{ActionID: actionID, Datetime: at.Format(datetimeLayout)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return o
+}
+
+// actionNames returns the names of actions in order.
+func actionNames(actions []*pb.Action) []string {
+	var names []string
+	for _, a := range actions {
+		names = append(names, a.GetName())
+	}
+	return names
+}
+
+func TestMergeOccurrences(t *testing.T) {
+	cases := []struct {
+		name string
+		in   []*pb.Occurrence
+		want *pb.Occurrence
+	}{
+		{
+			"data joined",
+			[]*pb.Occurrence{{ID: 1, Data: "5k"}, {ID: 2}, {ID: 3, Data: "stretched"}},
+			&pb.Occurrence{ID: 1, Data: "5k\nstretched"},
+		},
+		{
+			"later rating kept",
+			[]*pb.Occurrence{{ID: 1, Data: "5k"}, {ID: 2, Rating: 4}},
+			&pb.Occurrence{ID: 1, Data: "5k", Rating: 4},
+		},
+		{
+			"same ratings",
+			[]*pb.Occurrence{{ID: 1, Rating: 3}, {ID: 2, Rating: 3}},
+			&pb.Occurrence{ID: 1, Rating: 3},
+		},
+		{"different ratings", []*pb.Occurrence{{ID: 1, Rating: 3}, {ID: 2, Rating: 5}}, nil},
+		{
+			"data too long",
+			[]*pb.Occurrence{{ID: 1, Data: strings.Repeat("a", 200)}, {ID: 2, Data: strings.Repeat("b", 60)}},
+			nil,
+		},
+	}
+	for _, c := range cases {
+		got, ok := mergeOccurrences(c.in)
+		if ok != (c.want != nil) {
+			t.Errorf("%s: got ok %t, want %t", c.name, ok, c.want != nil)
+			continue
+		}
+		if ok && !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+
+	in := []*pb.Occurrence{{ID: 1, Data: "a"}, {ID: 2, Data: "b"}}
+	mergeOccurrences(in)
+	if in[0].GetData() != "a" {
+		t.Errorf("merging changed the first occurrence to %v", in[0])
+	}
+}
+
+func TestReadActionsMinOccurrences(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	at := time.Now().Add(-time.Hour)
+	createAction(t, s, "Never", 1)
+	once := createAction(t, s, "Once", 1)
+	createOccurrence(t, s, once.GetID(), at)
+	often := createAction(t, s, "Often", 1)
+	for i := 0; i < 3; i++ {
+		createOccurrence(t, s, often.GetID(), at)
+	}
+	createAction(t, s, "Other user", 2)
+
+	cases := []struct {
+		min  int64
+		want []string
+	}{
+		{0, []string{"Never", "Once", "Often"}},
+		{1, []string{"Once", "Often"}},
+		{3, []string{"Often"}},
+		{4, nil},
+	}
+	for _, c := range cases {
+		resp, err := s.ReadActions(context.Background(), &pb.ReadActionsRequest{UserID: 1, MinOccurrences: c.min})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := actionNames(resp.GetActions()); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("MinOccurrences %d: got %v, want %v", c.min, got, c.want)
+		}
+	}
+
+	if _, err := s.ReadActions(context.Background(), &pb.ReadActionsRequest{UserID: 1, MinOccurrences: -1}); err == nil {
+		t.Error("negative MinOccurrences was accepted")
+	}
+}
+
+func TestReconcileOccurrences(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	a := createAction(t, s, "Run", 1)
+	var ids []int64
+	for i := 0; i < 3; i++ {
+		ids = append(ids, createOccurrence(t, s, a.GetID(), time.Now().Add(-time.Hour)).GetID())
+	}
+
+	cases := []struct {
+		name       string
+		client     []int64
+		serverOnly []int64
+		clientOnly []int64
+	}{
+		{"disjoint", []int64{100, 101}, ids, []int64{100, 101}},
+		{"same", ids, nil, nil},
+		{"partial", []int64{ids[0], ids[1], 100}, ids[2:], []int64{100}},
+		{"duplicate client ids", []int64{100, 100, ids[0], ids[1], ids[2]}, nil, []int64{100}},
+	}
+	for _, c := range cases {
+		resp, err := s.ReconcileOccurrences(context.Background(), &pb.ReconcileOccurrencesRequest{
+			UserID:        1,
+			ActionID:      a.GetID(),
+			OccurrenceIDs: c.client,
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if !reflect.DeepEqual(resp.GetServerOnly(), c.serverOnly) {
+			t.Errorf("%s: got ServerOnly %v, want %v", c.name, resp.GetServerOnly(), c.serverOnly)
+		}
+		if !reflect.DeepEqual(resp.GetClientOnly(), c.clientOnly) {
+			t.Errorf("%s: got ClientOnly %v, want %v", c.name, resp.GetClientOnly(), c.clientOnly)
+		}
+	}
+
+	_, err := s.ReconcileOccurrences(context.Background(), &pb.ReconcileOccurrencesRequest{UserID: 2, ActionID: a.GetID()})
+	if err == nil {
+		t.Error("reconciled an action of another user")
+	}
+}
+
+func TestTouchActionOrdersByRecency(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	a := createAction(t, s, "A", 1)
+	b := createAction(t, s, "B", 1)
+	createAction(t, s, "C", 1)
+
+	before := time.Now().UnixNano()
+	for _, touched := range []*pb.Action{b, a} {
+		resp, err := s.TouchAction(context.Background(), &pb.Action{ID: touched.GetID(), UserID: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		stored, err := s.db.ReadActionByID(touched.GetID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetLastTouched() < before || stored.GetLastTouched() != resp.GetLastTouched() {
+			t.Errorf("touching %s returned LastTouched %d and stored %d, want equal and at least %d",
+				touched.GetName(), resp.GetLastTouched(), stored.GetLastTouched(), before)
+		}
+	}
+
+	resp, err := s.ReadActions(context.Background(), &pb.ReadActionsRequest{UserID: 1, OrderBy: "recency"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := actionNames(resp.GetActions()), []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got actions %v by recency, want %v", got, want)
+	}
+
+	if _, err := s.TouchAction(context.Background(), &pb.Action{ID: a.GetID(), UserID: 2}); err == nil {
+		t.Error("touched an action of another user")
+	}
+}
+
+func TestCloneActions(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	run, err := s.CreateAction(context.Background(), &pb.Action{
+		Name:        "Run",
+		UserID:      1,
+		Monotonic:   true,
+		Description: "Outside *only*",
+		Reminder:    &pb.Reminder{Times: []string{"07:30"}, Timezone: "America/Los_Angeles"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	createOccurrence(t, s, run.GetID(), time.Now().Add(-time.Hour))
+	createAction(t, s, "Yoga", 1)
+	createAction(t, s, "yoga", 2)
+
+	resp, err := s.CloneActions(context.Background(), &pb.CloneActionsRequest{UserID: 2, SourceUserID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetActions()) != 1 {
+		t.Fatalf("got %d cloned actions, want only Run: %v", len(resp.GetActions()), resp.GetActions())
+	}
+	clone := resp.GetActions()[0]
+	want := &pb.Action{
+		ID:          clone.GetID(),
+		Name:        run.GetName(),
+		UserID:      2,
+		Monotonic:   run.GetMonotonic(),
+		Description: run.GetDescription(),
+		Reminder:    run.GetReminder(),
+	}
+	if clone.GetID() == run.GetID() || !reflect.DeepEqual(clone, want) {
+		t.Errorf("got clone %v, want %v with a new ID", clone, want)
+	}
+	occurrences, err := s.db.ReadOccurrencesByActionID(clone.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occurrences) != 0 {
+		t.Errorf("clone has occurrences %v", occurrences)
+	}
+
+	actions, err := s.ReadActions(context.Background(), &pb.ReadActionsRequest{UserID: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := actionNames(actions.GetActions()), []string{"yoga", "Run"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("user has actions %v after cloning, want %v", got, want)
+	}
+
+	// Cloning again finds every name taken
+	resp, err = s.CloneActions(context.Background(), &pb.CloneActionsRequest{UserID: 2, SourceUserID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetActions()) != 0 {
+		t.Errorf("cloning again created %v", resp.GetActions())
+	}
+}
+
+func TestCreateOccurrenceRating(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	a := createAction(t, s, "Run", 1)
+	cases := []struct {
+		rating int64
+		ok     bool
+	}{
+		{0, true},
+		{1, true},
+		{5, true},
+		{-1, false},
+		{6, false},
+	}
+	for _, c := range cases {
+		o, err := s.CreateOccurrence(context.Background(), &pb.CreateOccurrenceRequest{
+			UserID:     1,
+			Occurrence: &pb.Occurrence{ActionID: a.GetID(), Rating: c.rating},
+		})
+		if (err == nil) != c.ok {
+			t.Errorf("Rating %d: got error %v, want ok %t", c.rating, err, c.ok)
+		}
+		if err != nil {
+			continue
+		}
+		stored, err := s.db.ReadOccurrencesByActionID(a.GetID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, so := range stored {
+			if so.GetID() == o.GetID() && so.GetRating() != c.rating {
+				t.Errorf("Rating %d: stored %d", c.rating, so.GetRating())
+			}
+		}
+	}
+}
+
+func TestAverageRating(t *testing.T) {
+	cases := []struct {
+		ratings []int64
+		want    float64
+	}{
+		{nil, 0},
+		{[]int64{0, 0}, 0},
+		{[]int64{4}, 4},
+		{[]int64{5, 0, 2}, 3.5},
+		{[]int64{1, 2, 2}, 5.0 / 3},
+	}
+	for _, c := range cases {
+		var occurrences []*pb.Occurrence
+		for _, r := range c.ratings {
+			occurrences = append(occurrences, &pb.Occurrence{Rating: r})
+		}
+		if got := averageRating(occurrences); got != c.want {
+			t.Errorf("ratings %v: got average %v, want %v", c.ratings, got, c.want)
+		}
+	}
+}
+
+func TestReadOccurrencesMultiAverageRating(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	a := createAction(t, s, "Run", 1)
+	day := time.Date(2016, 10, 3, 12, 0, 0, 0, time.UTC)
+	for i, r := range []int64{5, 0, 2, 1} {
+		o := &pb.Occurrence{ActionID: a.GetID(), Datetime: day.AddDate(0, 0, i).Format(datetimeLayout), Rating: r}
+		if _, err := s.db.CreateOccurrence(o); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The last, rated 1, is outside the range
+	resp, err := s.ReadOccurrencesMulti(context.Background(), &pb.OccurrencesMultiRequest{
+		UserID:    1,
+		ActionIDs: []int64{a.GetID()},
+		Start:     "2016-10-03T00:00:00Z",
+		End:       "2016-10-06T00:00:00Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.GetActions()[0].GetAverageRating(); got != 3.5 {
+		t.Errorf("got AverageRating %v, want 3.5", got)
+	}
+}
+
+func TestReadOccurrencesMulti(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	run := createAction(t, s, "Run", 1)
+	read := createAction(t, s, "Read", 1)
+	other := createAction(t, s, "Run", 2)
+	day := time.Date(2016, 10, 3, 12, 0, 0, 0, time.UTC)
+	pdt := time.FixedZone("PDT", -7*60*60)
+	// Stored out of order, and the last in a different zone
+	run2 := createOccurrence(t, s, run.GetID(), day.Add(2*time.Hour))
+	run1 := createOccurrence(t, s, run.GetID(), day)
+	read1 := createOccurrence(t, s, read.GetID(), day.Add(time.Hour))
+	read2 := createOccurrence(t, s, read.GetID(), day.Add(3*time.Hour).In(pdt))
+	createOccurrence(t, s, other.GetID(), day)
+
+	ids := func(occurrences []*pb.Occurrence) []int64 {
+		var ids []int64
+		for _, o := range occurrences {
+			ids = append(ids, o.GetID())
+		}
+		return ids
+	}
+	cases := []struct {
+		name       string
+		start, end string
+		run, read  []int64
+	}{
+		{"all", "", "", []int64{run1.GetID(), run2.GetID()}, []int64{read1.GetID(), read2.GetID()}},
+		{"start inclusive", "2016-10-03T13:00:00Z", "", []int64{run2.GetID()}, []int64{read1.GetID(), read2.GetID()}},
+		{"end exclusive", "", "2016-10-03T13:00:00Z", []int64{run1.GetID()}, nil},
+		{"range", "2016-10-03T06:00:00-07:00", "2016-10-03T15:00:00Z", []int64{run2.GetID()}, []int64{read1.GetID()}},
+	}
+	for _, c := range cases {
+		// Grouped in the order asked for, each action once
+		resp, err := s.ReadOccurrencesMulti(context.Background(), &pb.OccurrencesMultiRequest{
+			UserID:    1,
+			ActionIDs: []int64{read.GetID(), run.GetID(), read.GetID()},
+			Start:     c.start,
+			End:       c.end,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		groups := resp.GetActions()
+		if len(groups) != 2 || groups[0].GetActionID() != read.GetID() || groups[1].GetActionID() != run.GetID() {
+			t.Errorf("%s: got groups %v, want Read then Run", c.name, groups)
+			continue
+		}
+		if got := ids(groups[0].GetOccurrences()); !reflect.DeepEqual(got, c.read) {
+			t.Errorf("%s: got Read occurrences %v, want %v", c.name, got, c.read)
+		}
+		if got := ids(groups[1].GetOccurrences()); !reflect.DeepEqual(got, c.run) {
+			t.Errorf("%s: got Run occurrences %v, want %v", c.name, got, c.run)
+		}
+	}
+
+	for _, actionIDs := range [][]int64{{run.GetID(), other.GetID()}, {run.GetID(), 999}} {
+		_, err := s.ReadOccurrencesMulti(context.Background(), &pb.OccurrencesMultiRequest{UserID: 1, ActionIDs: actionIDs})
+		if err == nil || !strings.Contains(err.Error(), "not owned by user") {
+			t.Errorf("reading actions %v: got error %v, want not owned by user", actionIDs, err)
+		}
+	}
+}
+
+func TestCreateOccurrenceLocation(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+	s.geohashPrecision = 5
+
+	a := createAction(t, s, "Run", 1)
+	cases := []struct {
+		location *pb.Location
+		want     string
+	}{
+		{nil, ""},
+		{&pb.Location{}, "s0000"},
+		{&pb.Location{Latitude: 57.64911, Longitude: 10.40744}, "u4pru"},
+	}
+	for _, c := range cases {
+		o, err := s.CreateOccurrence(context.Background(), &pb.CreateOccurrenceRequest{
+			UserID:     1,
+			Occurrence: &pb.Occurrence{ActionID: a.GetID(), Geohash: "ignored"},
+			Location:   c.location,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o.GetGeohash() != c.want {
+			t.Errorf("Location %v: got geohash %q, want %q", c.location, o.GetGeohash(), c.want)
+		}
+	}
+}
+
+func TestReadFirstOccurrence(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	first, err := s.ReadFirstOccurrence(context.Background(), &pb.User{UserID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.GetID() != 0 {
+		t.Errorf("got first occurrence %v for a user without occurrences", first)
+	}
+
+	run := createAction(t, s, "Run", 1)
+	yoga := createAction(t, s, "Yoga", 1)
+	other := createAction(t, s, "Other", 2)
+	now := time.Now()
+	createOccurrence(t, s, run.GetID(), now.Add(-time.Hour))
+	want := createOccurrence(t, s, yoga.GetID(), now.Add(-48*time.Hour))
+	createOccurrence(t, s, run.GetID(), now.Add(-24*time.Hour))
+	createOccurrence(t, s, other.GetID(), now.Add(-72*time.Hour))
+	if _, err := s.db.CreateOccurrence(&pb.Occurrence{ActionID: run.GetID(), Datetime: "not a time"}); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err = s.ReadFirstOccurrence(context.Background(), &pb.User{UserID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.GetID() != want.GetID() {
+		t.Errorf("got first occurrence %v, want %v", first, want)
+	}
+}
+
+func TestReadDaySummary(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := createAction(t, s, "Run", 1)
+	yoga := createAction(t, s, "Yoga", 1)
+	createAction(t, s, "Unused", 1)
+	midnight := time.Date(2016, 10, 3, 0, 0, 0, 0, ny)
+	// Stored in UTC, 03:59 on the 3rd, but the 2nd in New York
+	createOccurrence(t, s, run.GetID(), midnight.Add(-time.Minute).UTC())
+	late := createOccurrence(t, s, run.GetID(), midnight.Add(24*time.Hour-time.Second))
+	early := createOccurrence(t, s, run.GetID(), midnight)
+	stretch := createOccurrence(t, s, yoga.GetID(), midnight.Add(12*time.Hour))
+	createOccurrence(t, s, yoga.GetID(), midnight.AddDate(0, 0, 1))
+
+	resp, err := s.ReadDaySummary(context.Background(), &pb.DaySummaryRequest{UserID: 1, Date: "2016-10-03", Timezone: "America/New_York"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		action      int64
+		occurrences []int64
+	}{
+		{run.GetID(), []int64{early.GetID(), late.GetID()}},
+		{yoga.GetID(), []int64{stretch.GetID()}},
+	}
+	if len(resp.GetActions()) != len(want) {
+		t.Fatalf("got %d actions, want %d: %v", len(resp.GetActions()), len(want), resp.GetActions())
+	}
+	for i, w := range want {
+		got := resp.GetActions()[i]
+		var ids []int64
+		for _, o := range got.GetOccurrences() {
+			ids = append(ids, o.GetID())
+		}
+		if got.GetAction().GetID() != w.action || got.GetCount() != int64(len(w.occurrences)) || !reflect.DeepEqual(ids, w.occurrences) {
+			t.Errorf("got action %d with count %d and occurrences %v, want action %d with occurrences %v",
+				got.GetAction().GetID(), got.GetCount(), ids, w.action, w.occurrences)
+		}
+	}
+
+	resp, err = s.ReadDaySummary(context.Background(), &pb.DaySummaryRequest{UserID: 1, Date: "2016-10-10", Timezone: "America/New_York"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetActions()) != 0 {
+		t.Errorf("got actions %v for an empty day", resp.GetActions())
+	}
+}
+
+func TestCreateOccurrenceMonotonic(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	monotonic, err := s.CreateAction(context.Background(), &pb.Action{Name: "Weigh in", UserID: 1, Monotonic: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := createAction(t, s, "Run", 1)
+	now := time.Now()
+	cases := []struct {
+		name   string
+		action int64
+		at     time.Time
+		ok     bool
+	}{
+		{"first", monotonic.GetID(), now.Add(-3 * time.Hour), true},
+		{"in order", monotonic.GetID(), now.Add(-time.Hour), true},
+		{"out of order", monotonic.GetID(), now.Add(-2 * time.Hour), false},
+		{"same time as latest", monotonic.GetID(), now.Add(-time.Hour), false},
+		{"backfill on another action", other.GetID(), now.Add(-24 * time.Hour), true},
+	}
+	for _, c := range cases {
+		_, err := s.CreateOccurrence(context.Background(), &pb.CreateOccurrenceRequest{
+			UserID:     1,
+			Occurrence: &pb.Occurrence{ActionID: c.action, Datetime: c.at.Format(time.RFC3339Nano)},
+		})
+		if (err == nil) != c.ok {
+			t.Errorf("%s: got error %v, want ok %t", c.name, err, c.ok)
+		}
+	}
+}
+
+func TestCreateOccurrenceMonotonicConcurrent(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	a, err := s.CreateAction(context.Background(), &pb.Action{Name: "Weigh in", UserID: 1, Monotonic: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := time.Now().Add(-time.Hour).Format(time.RFC3339Nano)
+
+	const n = 8
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := s.CreateOccurrence(context.Background(), &pb.CreateOccurrenceRequest{
+				UserID:     1,
+				Occurrence: &pb.Occurrence{ActionID: a.GetID(), Datetime: at},
+			})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	created := 0
+	for err := range errs {
+		if err == nil {
+			created++
+		} else if !strings.Contains(err.Error(), "monotonic") {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if created != 1 {
+		t.Errorf("%d concurrent creates at the same time succeeded, want 1", created)
+	}
+}
+
+func TestShiftOccurrences(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	run := createAction(t, s, "Run", 1)
+	yoga := createAction(t, s, "Yoga", 1)
+	other := createAction(t, s, "Other", 2)
+	now := time.Now()
+	day := createOccurrence(t, s, run.GetID(), now.Add(-24*time.Hour))
+	hour := createOccurrence(t, s, yoga.GetID(), now.Add(-time.Hour))
+	foreign := createOccurrence(t, s, other.GetID(), now.Add(-24*time.Hour))
+	at := map[int64]time.Time{
+		day.GetID():     now.Add(-24 * time.Hour),
+		hour.GetID():    now.Add(-time.Hour),
+		foreign.GetID(): now.Add(-24 * time.Hour),
+	}
+
+	cases := []struct {
+		name     string
+		actionID int64
+		offset   string
+		shifted  int64
+		moved    []int64
+		ok       bool
+	}{
+		{"zero", 0, "0s", 0, nil, true},
+		{"negative", 0, "-7h", 2, []int64{day.GetID(), hour.GetID()}, true},
+		{"positive for one action", run.GetID(), "2h", 1, []int64{day.GetID()}, true},
+		// Yoga's occurrence, 8h ago after the first shift, would be in the future
+		{"into the future", 0, "9h", 0, nil, false},
+		{"action of another user", other.GetID(), "-1h", 0, nil, false},
+	}
+	for _, c := range cases {
+		offset, err := time.ParseDuration(c.offset)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := s.ShiftOccurrences(context.Background(), &pb.ShiftOccurrencesRequest{
+			UserID:   1,
+			ActionID: c.actionID,
+			Offset:   c.offset,
+			Confirm:  true,
+		})
+		if (err == nil) != c.ok {
+			t.Errorf("%s: got error %v, want ok %t", c.name, err, c.ok)
+		}
+		if resp.GetShifted() != c.shifted {
+			t.Errorf("%s: got Shifted %d, want %d", c.name, resp.GetShifted(), c.shifted)
+		}
+		for _, id := range c.moved {
+			at[id] = at[id].Add(offset)
+		}
+		for _, a := range []*pb.Action{run, yoga, other} {
+			occurrences, err := s.db.ReadOccurrencesByActionID(a.GetID())
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, o := range occurrences {
+				got, err := parseStoredDatetime(o.GetDatetime())
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !got.Equal(at[o.GetID()]) {
+					t.Errorf("%s: occurrence %d is at %s, want %s", c.name, o.GetID(), got, at[o.GetID()])
+				}
+			}
+		}
+	}
+}
+
+func TestMaxBackfillAge(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	a := createAction(t, s, "Run", 1)
+	now := time.Now()
+	create := func(maxAge time.Duration, at time.Time) error {
+		s.maxBackfillAge = maxAge
+		_, err := s.CreateOccurrence(context.Background(), &pb.CreateOccurrenceRequest{
+			UserID:     1,
+			Occurrence: &pb.Occurrence{ActionID: a.GetID(), Datetime: at.Format(time.RFC3339Nano)},
+		})
+		return err
+	}
+	shift := func(maxAge time.Duration, offset string) error {
+		s.maxBackfillAge = maxAge
+		_, err := s.ShiftOccurrences(context.Background(), &pb.ShiftOccurrencesRequest{
+			UserID:   1,
+			ActionID: a.GetID(),
+			Offset:   offset,
+			Confirm:  true,
+		})
+		return err
+	}
+
+	if err := create(48*time.Hour, now.Add(-24*time.Hour)); err != nil {
+		t.Errorf("creating within the limit: %v", err)
+	}
+	if err := create(48*time.Hour, now.Add(-72*time.Hour)); err == nil {
+		t.Error("created an occurrence beyond the limit")
+	}
+	if err := create(0, now.Add(-10*365*24*time.Hour)); err != nil {
+		t.Errorf("creating without a limit: %v", err)
+	}
+
+	// The occurrences are now 1 day and 10 years old
+	if err := shift(0, "-1h"); err != nil {
+		t.Errorf("shifting back without a limit: %v", err)
+	}
+	if err := shift(100*365*24*time.Hour, "-1h"); err != nil {
+		t.Errorf("shifting back within the limit: %v", err)
+	}
+	if err := shift(5*365*24*time.Hour, "-1h"); err == nil {
+		t.Error("shifted an occurrence back beyond the limit")
+	}
+	if err := shift(5*365*24*time.Hour, "2h"); err != nil {
+		t.Errorf("shifting forward an occurrence already beyond the limit: %v", err)
+	}
+}
+
+func TestTransferAction(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	a := createAction(t, s, "Run", 1)
+	o := createOccurrence(t, s, a.GetID(), time.Now().Add(-time.Hour))
+
+	if _, err := s.TransferAction(context.Background(), &pb.TransferActionRequest{UserID: 2, ActionID: a.GetID(), NewUserID: 3}); err == nil {
+		t.Error("user 2 transferred an action of user 1")
+	}
+
+	moved, err := s.TransferAction(context.Background(), &pb.TransferActionRequest{UserID: 1, ActionID: a.GetID(), NewUserID: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.GetID() != a.GetID() || moved.GetUserID() != 2 {
+		t.Errorf("got transferred action %v", moved)
+	}
+	occurrences, err := s.db.ReadOccurrencesByUserID(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occurrences) != 1 || occurrences[0].GetID() != o.GetID() {
+		t.Errorf("new owner has occurrences %v, want the action's occurrence %d", occurrences, o.GetID())
+	}
+	occurrences, err = s.db.ReadOccurrencesByUserID(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occurrences) != 0 {
+		t.Errorf("old owner still has occurrences %v", occurrences)
+	}
+
+	if _, err := s.TransferAction(context.Background(), &pb.TransferActionRequest{UserID: 1, ActionID: a.GetID(), NewUserID: 3}); err == nil {
+		t.Error("user 1 transferred the action again after giving it away")
+	}
+}
+
+func TestReadUnloggedActions(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	run := createAction(t, s, "Run", 1)
+	createOccurrence(t, s, run.GetID(), time.Now().Add(-time.Hour))
+	createAction(t, s, "Read", 1)
+	createAction(t, s, "Yoga", 1)
+	createAction(t, s, "Walk", 2)
+
+	cases := []struct {
+		minAgeDays int64
+		want       []string
+	}{
+		{0, []string{"Read", "Yoga"}},
+		// Every action was created just now
+		{1, nil},
+		{1 << 40, nil},
+	}
+	for _, c := range cases {
+		resp, err := s.ReadUnloggedActions(context.Background(), &pb.ReadUnloggedActionsRequest{UserID: 1, MinAgeDays: c.minAgeDays})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := actionNames(resp.GetActions()); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("MinAgeDays %d: got %v, want %v", c.minAgeDays, got, c.want)
+		}
+	}
+
+	if _, err := s.ReadUnloggedActions(context.Background(), &pb.ReadUnloggedActionsRequest{UserID: 1, MinAgeDays: -1}); err == nil {
+		t.Error("negative MinAgeDays was not rejected")
+	}
+}
+
+func TestCompactOccurrences(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+	var skipped []interface{}
+	s.logger = log.LoggerFunc(func(keyvals ...interface{}) error {
+		for i := 0; i+1 < len(keyvals); i += 2 {
+			if keyvals[i] == "occurrence" {
+				skipped = append(skipped, keyvals[i+1])
+			}
+		}
+		return nil
+	})
+
+	a := createAction(t, s, "Run", 1)
+	create := func(datetime, data string, rating int64) int64 {
+		o, err := s.db.CreateOccurrence(&pb.Occurrence{ActionID: a.GetID(), Datetime: datetime, Data: data, Rating: rating})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return o.GetID()
+	}
+	// October 3rd in Los Angeles, the last on the 4th in UTC
+	late := create("2016-10-04 05:00:00 +0000 UTC", "", 4)
+	first := create("2016-10-03 15:00:00 +0000 UTC", "5k", 0)
+	second := create("2016-10-03 20:00:00 +0000 UTC", "10k", 0)
+	// Different ratings on the 5th are not merged
+	rated2 := create("2016-10-05 16:00:00 +0000 UTC", "", 2)
+	rated5 := create("2016-10-05 17:00:00 +0000 UTC", "", 5)
+	alone := create("2016-10-06 16:00:00 +0000 UTC", "", 0)
+	unparsable := create("yesterday", "", 0)
+
+	remaining := func() map[int64]*pb.Occurrence {
+		occurrences, err := s.db.ReadOccurrencesByActionID(a.GetID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		byID := make(map[int64]*pb.Occurrence)
+		for _, o := range occurrences {
+			byID[o.GetID()] = o
+		}
+		return byID
+	}
+	req := &pb.CompactOccurrencesRequest{UserID: 1, ActionID: a.GetID(), Timezone: "America/Los_Angeles", DryRun: true}
+
+	resp, err := s.CompactOccurrences(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetMerged() != 2 || resp.GetSkipped() != 1 {
+		t.Errorf("dry run: got %d merged and %d skipped, want 2 and 1", resp.GetMerged(), resp.GetSkipped())
+	}
+	if n := len(remaining()); n != 7 {
+		t.Errorf("dry run left %d occurrences, want all 7", n)
+	}
+
+	req.DryRun = false
+	resp, err = s.CompactOccurrences(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetMerged() != 2 || resp.GetSkipped() != 1 {
+		t.Errorf("got %d merged and %d skipped, want 2 and 1", resp.GetMerged(), resp.GetSkipped())
+	}
+	byID := remaining()
+	var ids []int64
+	for _, id := range []int64{first, second, late, rated2, rated5, alone, unparsable} {
+		if byID[id] != nil {
+			ids = append(ids, id)
+		}
+	}
+	if want := []int64{first, rated2, rated5, alone, unparsable}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("got occurrences %v left, want %v", ids, want)
+	}
+	if kept := byID[first]; kept.GetData() != "5k\n10k" || kept.GetRating() != 4 {
+		t.Errorf("kept occurrence has Data %q and Rating %d, want \"5k\\n10k\" and 4", kept.GetData(), kept.GetRating())
+	}
+	if len(skipped) == 0 || skipped[0] != unparsable {
+		t.Errorf("logged skipped occurrences %v, want %d", skipped, unparsable)
+	}
+}
+
+func TestCreateOccurrenceByActionName(t *testing.T) {
+	s, cleanup := newTestService(t)
+	defer cleanup()
+
+	run := createAction(t, s, "Run", 1)
+	createAction(t, s, "Swim", 2)
+	create := func(name string, autoCreate bool) (*pb.Occurrence, error) {
+		return s.CreateOccurrence(context.Background(), &pb.CreateOccurrenceRequest{
+			UserID:           1,
+			Occurrence:       &pb.Occurrence{},
+			ActionName:       name,
+			AutoCreateAction: autoCreate,
+		})
+	}
+	names := func() []string {
+		actions, err := s.db.ReadActions(1, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return actionNames(actions)
+	}
+
+	// An existing action is reused, names matching without case
+	for _, autoCreate := range []bool{false, true} {
+		o, err := create("run", autoCreate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o.GetActionID() != run.GetID() {
+			t.Errorf("auto create %t: occurrence logged against action %d, want Run %d", autoCreate, o.GetActionID(), run.GetID())
+		}
+	}
+
+	// Another user's Swim is not found
+	if _, err := create("Swim", false); err == nil || !strings.Contains(err.Error(), `no action named "Swim"`) {
+		t.Errorf("without auto create: got error %v, want no action named \"Swim\"", err)
+	}
+	if got, want := names(), []string{"Run"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("without auto create: got actions %v, want %v", got, want)
+	}
+
+	o, err := create("Swim", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	swim, err := s.db.ReadActionByID(o.GetActionID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if swim.GetName() != "Swim" || swim.GetUserID() != 1 {
+		t.Errorf("auto created action %v, want Swim for user 1", swim)
+	}
+	again, err := create("Swim", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.GetActionID() != swim.GetID() {
+		t.Errorf("second occurrence logged against action %d, want the created Swim %d", again.GetActionID(), swim.GetID())
+	}
+	if got, want := names(), []string{"Run", "Swim"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("with auto create: got actions %v, want %v", got, want)
+	}
+}
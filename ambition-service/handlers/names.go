@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// caseSensitiveNamesFromENV reads ACTION_NAME_UNIQUENESS, which decides
+// whether action names that differ only in case are the same name. Unset or
+// "case-insensitive" makes "Run" and "run" the same action, "case-sensitive"
+// makes them different actions.
+func caseSensitiveNamesFromENV() (bool, error) {
+	switch mode := os.Getenv("ACTION_NAME_UNIQUENESS"); mode {
+	case "", "case-insensitive":
+		return false, nil
+	case "case-sensitive":
+		return true, nil
+	default:
+		return false, errors.Errorf("ACTION_NAME_UNIQUENESS must be case-sensitive or case-insensitive, got %q", mode)
+	}
+}
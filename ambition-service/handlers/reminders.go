@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	pb "github.com/adamryman/ambition-model/ambition-service"
+)
+
+// reminderTimeLayout is the format of Reminder.Times.
+const reminderTimeLayout = "15:04"
+
+// maxReminderTimes caps how many times of day a reminder may have, one every
+// half hour. A reminder at the cap still fits the 1024 characters of
+// actions.reminder once encoded.
+const maxReminderTimes = 48
+
+// normalizeReminder returns r with its times written as HH:MM and its times
+// and weekdays deduplicated, or an error if r is not a valid reminder. A
+// reminder without times means no reminder, and nil is returned for it.
+func normalizeReminder(r *pb.Reminder) (*pb.Reminder, error) {
+	if len(r.GetTimes()) == 0 {
+		return nil, nil
+	}
+	var times []string
+	seenTimes := make(map[string]bool)
+	for _, v := range r.GetTimes() {
+		t, err := time.Parse(reminderTimeLayout, v)
+		if err != nil {
+			return nil, errors.Errorf("reminder times must be HH:MM, got %q", v)
+		}
+		v = t.Format(reminderTimeLayout)
+		if !seenTimes[v] {
+			seenTimes[v] = true
+			times = append(times, v)
+		}
+	}
+	if len(times) > maxReminderTimes {
+		return nil, errors.Errorf("reminder must have at most %d times, got %d", maxReminderTimes, len(times))
+	}
+	var weekdays []int64
+	seenWeekdays := make(map[int64]bool)
+	for _, d := range r.GetWeekdays() {
+		if d < int64(time.Sunday) || d > int64(time.Saturday) {
+			return nil, errors.Errorf("reminder weekdays must be between 0 and 6, got %d", d)
+		}
+		if !seenWeekdays[d] {
+			seenWeekdays[d] = true
+			weekdays = append(weekdays, d)
+		}
+	}
+	if r.GetTimezone() == "" {
+		return nil, errors.New("reminder needs a Timezone")
+	}
+	if _, err := time.LoadLocation(r.GetTimezone()); err != nil {
+		return nil, errors.Errorf("unknown reminder Timezone %q", r.GetTimezone())
+	}
+
+	return &pb.Reminder{
+		Times:    times,
+		Weekdays: weekdays,
+		Timezone: r.GetTimezone(),
+	}, nil
+}
+
+// reminderDue reports whether r is due in the minute containing at.
+func reminderDue(r *pb.Reminder, at time.Time) bool {
+	if len(r.GetTimes()) == 0 {
+		return false
+	}
+	loc, err := time.LoadLocation(r.GetTimezone())
+	if err != nil {
+		return false
+	}
+	local := at.In(loc)
+
+	if days := r.GetWeekdays(); len(days) > 0 {
+		var today bool
+		for _, d := range days {
+			if time.Weekday(d) == local.Weekday() {
+				today = true
+			}
+		}
+		if !today {
+			return false
+		}
+	}
+	now := local.Format(reminderTimeLayout)
+	for _, t := range r.GetTimes() {
+		if t == now {
+			return true
+		}
+	}
+	return false
+}
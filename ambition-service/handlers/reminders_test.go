@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "github.com/adamryman/ambition-model/ambition-service"
+)
+
+func TestNormalizeReminder(t *testing.T) {
+	got, err := normalizeReminder(&pb.Reminder{
+		Times:    []string{"8:00", "20:30", "08:00", "20:30"},
+		Weekdays: []int64{1, 3, 1, 5},
+		Timezone: "America/Los_Angeles",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.Reminder{
+		Times:    []string{"08:00", "20:30"},
+		Weekdays: []int64{1, 3, 5},
+		Timezone: "America/Los_Angeles",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if r, err := normalizeReminder(&pb.Reminder{Timezone: "UTC"}); r != nil || err != nil {
+		t.Errorf("reminder without times: got %v, %v, want nil, nil", r, err)
+	}
+}
+
+func TestNormalizeReminderInvalid(t *testing.T) {
+	cases := []struct {
+		name string
+		in   *pb.Reminder
+	}{
+		{"time", &pb.Reminder{Times: []string{"25:00"}, Timezone: "UTC"}},
+		{"weekday", &pb.Reminder{Times: []string{"08:00"}, Weekdays: []int64{7}, Timezone: "UTC"}},
+		{"no timezone", &pb.Reminder{Times: []string{"08:00"}}},
+		{"unknown timezone", &pb.Reminder{Times: []string{"08:00"}, Timezone: "Mars/Olympus_Mons"}},
+		{"too many times", &pb.Reminder{Times: everyMinutes(maxReminderTimes + 1), Timezone: "UTC"}},
+	}
+	for _, c := range cases {
+		if r, err := normalizeReminder(c.in); err == nil {
+			t.Errorf("%s: %v was not rejected, got %v", c.name, c.in, r)
+		}
+	}
+}
+
+func TestNormalizeReminderFitsColumn(t *testing.T) {
+	r, err := normalizeReminder(&pb.Reminder{
+		Times:    everyMinutes(maxReminderTimes),
+		Weekdays: []int64{0, 1, 2, 3, 4, 5, 6},
+		Timezone: "America/Argentina/ComodRivadavia",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The size of actions.reminder
+	if len(b) > 1024 {
+		t.Errorf("largest reminder encodes to %d characters, more than fit in actions.reminder", len(b))
+	}
+}
+
+func TestReminderDue(t *testing.T) {
+	// A Monday
+	at := time.Date(2016, 10, 3, 15, 0, 30, 0, time.UTC)
+	cases := []struct {
+		name string
+		r    *pb.Reminder
+		want bool
+	}{
+		{"due", &pb.Reminder{Times: []string{"15:00"}, Timezone: "UTC"}, true},
+		{"one of several times", &pb.Reminder{Times: []string{"09:00", "15:00"}, Timezone: "UTC"}, true},
+		{"not yet due", &pb.Reminder{Times: []string{"15:01"}, Timezone: "UTC"}, false},
+		{"already past", &pb.Reminder{Times: []string{"14:59"}, Timezone: "UTC"}, false},
+		{"due in timezone", &pb.Reminder{Times: []string{"08:00"}, Timezone: "America/Los_Angeles"}, true},
+		{"utc time in other timezone", &pb.Reminder{Times: []string{"15:00"}, Timezone: "America/Los_Angeles"}, false},
+		{"due weekday", &pb.Reminder{Times: []string{"15:00"}, Weekdays: []int64{1}, Timezone: "UTC"}, true},
+		{"other weekday", &pb.Reminder{Times: []string{"15:00"}, Weekdays: []int64{2}, Timezone: "UTC"}, false},
+		// 04:00 on Tuesday in Auckland is 15:00 on Monday in UTC
+		{"weekday in timezone", &pb.Reminder{Times: []string{"04:00"}, Weekdays: []int64{2}, Timezone: "Pacific/Auckland"}, true},
+		{"no reminder", nil, false},
+	}
+	for _, c := range cases {
+		if got := reminderDue(c.r, at); got != c.want {
+			t.Errorf("%s: got %t, want %t", c.name, got, c.want)
+		}
+	}
+}
+
+// everyMinutes returns n distinct HH:MM times starting at midnight.
+func everyMinutes(n int) []string {
+	times := make([]string, n)
+	for i := range times {
+		times[i] = fmt.Sprintf("%02d:%02d", i/60, i%60)
+	}
+	return times
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	pb "github.com/adamryman/ambition-model/ambition-service"
+)
+
+// store is what the service needs from a database. Both the mysql and the
+// sqlite Database implement it, the tests run the service against sqlite.
+type store interface {
+	CreateAction(in *pb.Action) (*pb.Action, error)
+	CreateOccurrence(in *pb.Occurrence) (*pb.Occurrence, error)
+	CreateOccurrenceChecked(in *pb.Occurrence, check func(existing []*pb.Occurrence) error) (*pb.Occurrence, error)
+	CreateActionWithOccurrence(action *pb.Action, occurrence *pb.Occurrence) (*pb.Action, *pb.Occurrence, error)
+	ReadActionByID(id int64) (*pb.Action, error)
+	ReadActionByNameAndUserID(name string, userID int64) (*pb.Action, error)
+	CloneActions(sourceUserID int64, userID int64) ([]*pb.Action, error)
+	TouchAction(id int64, at int64) error
+	TransferAction(id int64, fromUserID int64, userID int64) error
+	ReadActions(userID int64, minOccurrences int64, byRecency bool) ([]*pb.Action, error)
+	ReadUnloggedActions(userID int64, createdBefore int64) ([]*pb.Action, error)
+	ReadUserUsage(userID int64) (actions, occurrences, dataBytes int64, err error)
+	ReadOccurrenceIDsByActionID(actionID int64) ([]int64, error)
+	ReadOccurrencesByActionID(actionID int64) ([]*pb.Occurrence, error)
+	ReadOccurrencesByUserID(userID int64) ([]*pb.Occurrence, error)
+	ReadActionsByIDs(ids []int64) ([]*pb.Action, error)
+	ReadOccurrencesByActionIDs(ids []int64) ([]*pb.Occurrence, error)
+	ShiftOccurrenceDatetimes(userID int64, actionID int64, shift func(occurrences []*pb.Occurrence) (map[int64]string, error)) error
+	MergeOccurrences(kept []*pb.Occurrence, deleted []int64) error
+}
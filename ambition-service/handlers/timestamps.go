@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// datetimeLayout is the format occurrence datetimes are stored in. It matches
+// the output of time.Time.String().
+const datetimeLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
+// dateLayout is the format of calendar dates sent by clients.
+const dateLayout = "2006-01-02"
+
+// zonelessLayout is RFC3339Nano without an offset. Lenient parsing reads it
+// as UTC.
+const zonelessLayout = "2006-01-02T15:04:05.999999999"
+
+// millisThreshold separates unix seconds from unix milliseconds. Unix seconds
+// will not reach it until the year 33658.
+const millisThreshold = 1e12
+
+// timeParser parses timestamps sent by clients.
+//
+// In lenient mode it accepts RFC3339 and RFC3339Nano, with or without an
+// offset, as well as unix seconds or milliseconds. Timestamps without an
+// offset are read as UTC.
+//
+// In strict mode only RFC3339Nano with an explicit offset is accepted.
+type timeParser struct {
+	strict bool
+}
+
+// timeParserFromENV returns a strict timeParser if TIMESTAMP_PARSING is set to
+// "strict" and a lenient one if it is unset or "lenient".
+func timeParserFromENV() (timeParser, error) {
+	switch mode := os.Getenv("TIMESTAMP_PARSING"); mode {
+	case "", "lenient":
+		return timeParser{}, nil
+	case "strict":
+		return timeParser{strict: true}, nil
+	default:
+		return timeParser{}, errors.Errorf("TIMESTAMP_PARSING must be strict or lenient, got %q", mode)
+	}
+}
+
+// Parse parses value according to the mode of p.
+func (p timeParser) Parse(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	if p.strict {
+		return time.Time{}, errors.Errorf("cannot parse timestamp %q, must be RFC3339Nano with an explicit offset", value)
+	}
+
+	if t, err := time.Parse(zonelessLayout, value); err == nil {
+		return t, nil
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if n >= millisThreshold || n <= -millisThreshold {
+			return time.Unix(0, n*int64(time.Millisecond)).UTC(), nil
+		}
+		return time.Unix(n, 0).UTC(), nil
+	}
+	return time.Time{}, errors.Errorf("cannot parse timestamp %q, must be RFC3339, RFC3339Nano, or unix seconds or milliseconds", value)
+}
+
+// parseStoredDatetime parses an occurrence datetime read from the store.
+// Occurrences created before timestamps were parsed may hold whatever the
+// client sent, so those are read leniently.
+func parseStoredDatetime(value string) (time.Time, error) {
+	if t, err := time.Parse(datetimeLayout, value); err == nil {
+		return t, nil
+	}
+	return timeParser{}.Parse(value)
+}
+
+// maxBackfillAgeFromENV reads OCCURRENCE_MAX_BACKFILL_AGE, how far in the past
+// a created occurrence may be, as a duration such as "8760h". Zero or unset
+// means there is no limit.
+func maxBackfillAgeFromENV() (time.Duration, error) {
+	v := os.Getenv("OCCURRENCE_MAX_BACKFILL_AGE")
+	if v == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, errors.Errorf("OCCURRENCE_MAX_BACKFILL_AGE must be a non-negative duration such as 8760h, got %q", v)
+	}
+	return d, nil
+}
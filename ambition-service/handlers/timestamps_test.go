@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeParserLenient(t *testing.T) {
+	cases := []struct {
+		name  string
+		value string
+		want  time.Time
+	}{
+		{"RFC3339", "2016-10-01T08:30:00-07:00", time.Date(2016, 10, 1, 15, 30, 0, 0, time.UTC)},
+		{"RFC3339 UTC", "2016-10-01T08:30:00Z", time.Date(2016, 10, 1, 8, 30, 0, 0, time.UTC)},
+		{"RFC3339Nano", "2016-10-01T08:30:00.123456789+02:00", time.Date(2016, 10, 1, 6, 30, 0, 123456789, time.UTC)},
+		{"zoneless", "2016-10-01T08:30:00", time.Date(2016, 10, 1, 8, 30, 0, 0, time.UTC)},
+		{"zoneless fractional", "2016-10-01T08:30:00.5", time.Date(2016, 10, 1, 8, 30, 0, 500000000, time.UTC)},
+		{"unix seconds", "1475310600", time.Date(2016, 10, 1, 8, 30, 0, 0, time.UTC)},
+		{"unix millis", "1475310600250", time.Date(2016, 10, 1, 8, 30, 0, 250000000, time.UTC)},
+	}
+	for _, c := range cases {
+		got, err := timeParser{}.Parse(c.value)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", c.name, err)
+			continue
+		}
+		if !got.Equal(c.want) {
+			t.Errorf("%s: %q parsed as %v, want %v", c.name, c.value, got, c.want)
+		}
+	}
+
+	if _, err := (timeParser{}).Parse("October 1st"); err == nil {
+		t.Error("lenient parser accepted \"October 1st\"")
+	}
+}
+
+func TestTimeParserStrict(t *testing.T) {
+	strict := timeParser{strict: true}
+	if _, err := strict.Parse("2016-10-01T08:30:00.5-07:00"); err != nil {
+		t.Errorf("strict parser rejected RFC3339Nano with an offset: %v", err)
+	}
+	for _, value := range []string{"2016-10-01T08:30:00", "2016-10-01T08:30:00.5", "1475310600"} {
+		if got, err := strict.Parse(value); err == nil {
+			t.Errorf("strict parser accepted %q as %v", value, got)
+		}
+	}
+}
+
+func TestParseStoredDatetime(t *testing.T) {
+	want := time.Date(2016, 10, 1, 8, 30, 0, 0, time.UTC)
+	la, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []string{want.In(la).Format(datetimeLayout), want.Format(time.RFC3339)} {
+		got, err := parseStoredDatetime(value)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", value, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("%q parsed as %v, want %v", value, got, want)
+		}
+	}
+}
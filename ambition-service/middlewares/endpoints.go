@@ -1,7 +1,8 @@
 package middlewares
 
 import (
-	_ "github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/pkg/errors"
 
 	"github.com/adamryman/ambition-model/ambition-service/svc"
 )
@@ -22,5 +23,64 @@ func WrapEndpoints(in svc.Endpoints) svc.Endpoints {
 	// How to apply a middleware to a single endpoint.
 	// in.ExampleEndpoint = authMiddleware(in.ExampleEndpoint)
 
+	// Shed reads under load so that writes keep going through.
+	// Only the endpoints named by SHED_ENDPOINTS are low priority and
+	// shed, every other endpoint is never shed.
+	if max := maxInFlightFromENV(); max > 0 {
+		var f inFlight
+		in.WrapAllExcept(f.Count())
+		shed := f.Shed(max)
+		byName := endpointsByName(&in)
+		for _, name := range sheddableEndpointsFromENV() {
+			e, ok := byName[name]
+			if !ok {
+				panic(errors.Errorf("SHED_ENDPOINTS names unknown endpoint %q", name))
+			}
+			*e = shed(*e)
+		}
+	}
+
 	return in
 }
+
+// defaultSheddableEndpoints are the low priority endpoints shed under load
+// when SHED_ENDPOINTS is unset. They only read, so new endpoints, which may
+// write, are never shed unless they are added here.
+var defaultSheddableEndpoints = []string{
+	"ReadAction",
+	"ReadActions",
+	"ReadOccurrencesByDate",
+	"ReadOccurrences",
+	"ReconcileOccurrences",
+	"ReadFirstOccurrence",
+	"ReadDaySummary",
+	"ReadUnloggedActions",
+	"ReadDueReminders",
+	"ReadOccurrencesMulti",
+	"ReadUserUsage",
+}
+
+// endpointsByName returns every endpoint of in keyed by name, so that they
+// can be wrapped in place.
+func endpointsByName(in *svc.Endpoints) map[string]*endpoint.Endpoint {
+	return map[string]*endpoint.Endpoint{
+		"CreateAction":          &in.CreateActionEndpoint,
+		"CreateOccurrence":      &in.CreateOccurrenceEndpoint,
+		"ReadAction":            &in.ReadActionEndpoint,
+		"ReadActions":           &in.ReadActionsEndpoint,
+		"ReadOccurrencesByDate": &in.ReadOccurrencesByDateEndpoint,
+		"ReadOccurrences":       &in.ReadOccurrencesEndpoint,
+		"ReconcileOccurrences":  &in.ReconcileOccurrencesEndpoint,
+		"TouchAction":           &in.TouchActionEndpoint,
+		"CloneActions":          &in.CloneActionsEndpoint,
+		"ReadFirstOccurrence":   &in.ReadFirstOccurrenceEndpoint,
+		"ReadDaySummary":        &in.ReadDaySummaryEndpoint,
+		"ShiftOccurrences":      &in.ShiftOccurrencesEndpoint,
+		"TransferAction":        &in.TransferActionEndpoint,
+		"ReadUnloggedActions":   &in.ReadUnloggedActionsEndpoint,
+		"ReadDueReminders":      &in.ReadDueRemindersEndpoint,
+		"ReadOccurrencesMulti":  &in.ReadOccurrencesMultiEndpoint,
+		"CompactOccurrences":    &in.CompactOccurrencesEndpoint,
+		"ReadUserUsage":         &in.ReadUserUsageEndpoint,
+	}
+}
@@ -0,0 +1,102 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// defaultMaxPathLength is the longest request path accepted when
+// HTTP_MAX_PATH_LENGTH is unset.
+const defaultMaxPathLength = 2048
+
+// defaultMaxQueryParams is the most distinct query parameters accepted when
+// HTTP_MAX_QUERY_PARAMS is unset.
+const defaultMaxQueryParams = 100
+
+// WrapHTTPHandler wraps the service's HTTP handler with guards that run
+// before any routing or path parameter parsing is done.
+func WrapHTTPHandler(in http.Handler) http.Handler {
+	maxPath := intFromENV("HTTP_MAX_PATH_LENGTH", defaultMaxPathLength)
+	if maxPath > 0 {
+		in = maxPathLength(in, maxPath)
+	}
+	maxQuery := intFromENV("HTTP_MAX_QUERY_PARAMS", defaultMaxQueryParams)
+	if maxQuery > 0 {
+		in = maxQueryParams(in, maxQuery)
+	}
+
+	return in
+}
+
+// maxPathLength rejects requests whose path is longer than max with
+// 414 Request-URI Too Long.
+func maxPathLength(next http.Handler, max int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.URL.Path) > max {
+			http.Error(w, http.StatusText(http.StatusRequestURITooLong), http.StatusRequestURITooLong)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// maxQueryParams rejects requests with more than max distinct query parameter
+// keys with 400 Bad Request, before the query is parsed into url.Values.
+func maxQueryParams(next http.Handler, max int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if tooManyQueryParams(r.URL.RawQuery, max) {
+			msg := fmt.Sprintf("too many query parameters, at most %d are allowed", max)
+			http.Error(w, msg, http.StatusBadRequest)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// tooManyQueryParams reports whether query has more than max distinct keys.
+// Repeated keys count once. It stops reading as soon as max is passed.
+func tooManyQueryParams(query string, max int) bool {
+	keys := make(map[string]struct{})
+	for query != "" {
+		key := query
+		if i := strings.IndexAny(key, "&;"); i >= 0 {
+			key, query = key[:i], key[i+1:]
+		} else {
+			query = ""
+		}
+		if i := strings.Index(key, "="); i >= 0 {
+			key = key[:i]
+		}
+		if key == "" {
+			continue
+		}
+		if k, err := url.QueryUnescape(key); err == nil {
+			key = k
+		}
+		keys[key] = struct{}{}
+		if len(keys) > max {
+			return true
+		}
+	}
+	return false
+}
+
+// intFromENV reads a non-negative integer from the environment variable key,
+// returning def if it is unset.
+func intFromENV(key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		panic(errors.Errorf("%s must be a non-negative integer, got %q", key, v))
+	}
+	return n
+}
@@ -0,0 +1,63 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var okHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+})
+
+func TestMaxPathLength(t *testing.T) {
+	h := maxPathLength(okHandler, 16)
+	cases := []struct {
+		path string
+		want int
+	}{
+		{"/" + strings.Repeat("a", 15), http.StatusOK},
+		{"/" + strings.Repeat("a", 16), http.StatusRequestURITooLong},
+	}
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", c.path, nil))
+		if w.Code != c.want {
+			t.Errorf("path of %d characters: got status %d, want %d", len(c.path), w.Code, c.want)
+		}
+	}
+}
+
+func TestMaxQueryParams(t *testing.T) {
+	h := maxQueryParams(okHandler, 3)
+	cases := []struct {
+		query string
+		want  int
+	}{
+		{"a=1&b=2&c=3", http.StatusOK},
+		{"a=1&b=2&c=3&d=4", http.StatusBadRequest},
+		// Repeated keys count once
+		{"a=1&a=2&b=3&b=4&c=5&c=6", http.StatusOK},
+		{"a=1&%61=2&b&c;d", http.StatusBadRequest},
+		{"&&&a=1&&", http.StatusOK},
+	}
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", "/?"+c.query, nil))
+		if w.Code != c.want {
+			t.Errorf("query %q: got status %d, want %d", c.query, w.Code, c.want)
+		}
+	}
+}
+
+func TestTooManyQueryParamsStopsEarly(t *testing.T) {
+	var query []string
+	for i := 0; i < 100000; i++ {
+		query = append(query, fmt.Sprintf("k%d=v", i))
+	}
+	if !tooManyQueryParams(strings.Join(query, "&"), 100) {
+		t.Error("100000 distinct keys were not over a cap of 100")
+	}
+}
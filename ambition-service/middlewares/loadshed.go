@@ -0,0 +1,69 @@
+package middlewares
+
+import (
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-kit/kit/endpoint"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// ErrOverloaded is returned by endpoints that are shedding load.
+var ErrOverloaded = grpc.Errorf(codes.Unavailable, "server overloaded, try again later")
+
+// maxInFlightFromENV reads SHED_MAX_IN_FLIGHT, the number of in-flight
+// requests at which low priority endpoints start being shed. Zero or unset
+// disables load shedding.
+func maxInFlightFromENV() int64 {
+	return int64(intFromENV("SHED_MAX_IN_FLIGHT", 0))
+}
+
+// sheddableEndpointsFromENV reads SHED_ENDPOINTS, a comma separated list of
+// the endpoints that are shed under load, returning
+// defaultSheddableEndpoints if it is unset.
+func sheddableEndpointsFromENV() []string {
+	v := os.Getenv("SHED_ENDPOINTS")
+	if v == "" {
+		return defaultSheddableEndpoints
+	}
+	var names []string
+	for _, name := range strings.Split(v, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// inFlight tracks the number of requests currently being served.
+type inFlight struct {
+	n int64
+}
+
+// Count returns a middleware that counts requests while they are in flight.
+func (f *inFlight) Count() endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			atomic.AddInt64(&f.n, 1)
+			defer atomic.AddInt64(&f.n, -1)
+			return next(ctx, request)
+		}
+	}
+}
+
+// Shed returns a middleware that rejects requests with ErrOverloaded while
+// max or more requests are in flight. It must wrap outside of Count so that
+// the request being checked is not counted against itself.
+func (f *inFlight) Shed(max int64) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			if atomic.LoadInt64(&f.n) >= max {
+				return nil, ErrOverloaded
+			}
+			return next(ctx, request)
+		}
+	}
+}
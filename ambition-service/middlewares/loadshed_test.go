@@ -0,0 +1,99 @@
+package middlewares
+
+import (
+	"os"
+	"testing"
+
+	"github.com/go-kit/kit/endpoint"
+	"golang.org/x/net/context"
+
+	"github.com/adamryman/ambition-model/ambition-service/svc"
+)
+
+// overloaded returns endpoints wrapped by WrapEndpoints with one request in
+// flight on CreateOccurrence, and a func that completes that request.
+func overloaded() (svc.Endpoints, func()) {
+	var in svc.Endpoints
+	ok := func(ctx context.Context, request interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	for _, e := range endpointsByName(&in) {
+		*e = ok
+	}
+	started, release := make(chan struct{}), make(chan struct{})
+	in.CreateOccurrenceEndpoint = func(ctx context.Context, request interface{}) (interface{}, error) {
+		close(started)
+		<-release
+		return "ok", nil
+	}
+
+	os.Setenv("SHED_MAX_IN_FLIGHT", "1")
+	defer os.Unsetenv("SHED_MAX_IN_FLIGHT")
+	out := WrapEndpoints(in)
+
+	done := make(chan struct{})
+	go func() {
+		out.CreateOccurrenceEndpoint(context.Background(), nil)
+		close(done)
+	}()
+	<-started
+	return out, func() {
+		close(release)
+		<-done
+	}
+}
+
+func TestWrapEndpointsShedsLowPriority(t *testing.T) {
+	out, finish := overloaded()
+
+	if _, err := out.ReadActionsEndpoint(context.Background(), nil); err != ErrOverloaded {
+		t.Errorf("ReadActions under load: got error %v, want %v", err, ErrOverloaded)
+	}
+	for name, e := range map[string]endpoint.Endpoint{
+		"CreateAction":       out.CreateActionEndpoint,
+		"TouchAction":        out.TouchActionEndpoint,
+		"CompactOccurrences": out.CompactOccurrencesEndpoint,
+	} {
+		if _, err := e(context.Background(), nil); err != nil {
+			t.Errorf("%s under load: unexpected error %v", name, err)
+		}
+	}
+
+	finish()
+	if _, err := out.ReadActionsEndpoint(context.Background(), nil); err != nil {
+		t.Errorf("ReadActions after load: unexpected error %v", err)
+	}
+}
+
+func TestWrapEndpointsShedEndpointsFromENV(t *testing.T) {
+	os.Setenv("SHED_ENDPOINTS", "CreateAction, ReadActions")
+	defer os.Unsetenv("SHED_ENDPOINTS")
+	out, finish := overloaded()
+	defer finish()
+
+	for name, e := range map[string]endpoint.Endpoint{
+		"CreateAction": out.CreateActionEndpoint,
+		"ReadActions":  out.ReadActionsEndpoint,
+	} {
+		if _, err := e(context.Background(), nil); err != ErrOverloaded {
+			t.Errorf("%s under load: got error %v, want %v", name, err, ErrOverloaded)
+		}
+	}
+	if _, err := out.ReadDaySummaryEndpoint(context.Background(), nil); err != nil {
+		t.Errorf("ReadDaySummary under load: unexpected error %v", err)
+	}
+}
+
+func TestWrapEndpointsUnknownShedEndpoint(t *testing.T) {
+	os.Setenv("SHED_MAX_IN_FLIGHT", "1")
+	defer os.Unsetenv("SHED_MAX_IN_FLIGHT")
+	os.Setenv("SHED_ENDPOINTS", "ReadEverything")
+	defer os.Unsetenv("SHED_ENDPOINTS")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("unknown endpoint in SHED_ENDPOINTS did not panic")
+		}
+	}()
+	WrapEndpoints(svc.Endpoints{})
+}
@@ -9,38 +9,51 @@ import (
 )
 
 // CreateAction implements Service.
-func CreateAction(IDCreateAction int64, NameCreateAction string, UserIDCreateAction int64) (*pb.Action, error) {
+func CreateAction(IDCreateAction int64, NameCreateAction string, UserIDCreateAction int64, LastTouchedCreateAction int64, MonotonicCreateAction bool, DescriptionCreateAction string, ReminderCreateAction pb.Reminder) (*pb.Action, error) {
 	request := pb.Action{
-		ID:     IDCreateAction,
-		Name:   NameCreateAction,
-		UserID: UserIDCreateAction,
+		ID:          IDCreateAction,
+		Name:        NameCreateAction,
+		UserID:      UserIDCreateAction,
+		LastTouched: LastTouchedCreateAction,
+		Monotonic:   MonotonicCreateAction,
+		Description: DescriptionCreateAction,
+		Reminder:    &ReminderCreateAction,
 	}
 	return &request, nil
 }
 
 // CreateOccurrence implements Service.
-func CreateOccurrence(UserIDCreateOccurrence int64, OccurrenceCreateOccurrence pb.Occurrence) (*pb.CreateOccurrenceRequest, error) {
+func CreateOccurrence(UserIDCreateOccurrence int64, OccurrenceCreateOccurrence pb.Occurrence, LocationCreateOccurrence *pb.Location, ActionNameCreateOccurrence string, AutoCreateActionCreateOccurrence bool) (*pb.CreateOccurrenceRequest, error) {
 	request := pb.CreateOccurrenceRequest{
-		UserID:     UserIDCreateOccurrence,
-		Occurrence: &OccurrenceCreateOccurrence,
+		UserID:           UserIDCreateOccurrence,
+		Occurrence:       &OccurrenceCreateOccurrence,
+		Location:         LocationCreateOccurrence,
+		ActionName:       ActionNameCreateOccurrence,
+		AutoCreateAction: AutoCreateActionCreateOccurrence,
 	}
 	return &request, nil
 }
 
 // ReadAction implements Service.
-func ReadAction(IDReadAction int64, NameReadAction string, UserIDReadAction int64) (*pb.Action, error) {
+func ReadAction(IDReadAction int64, NameReadAction string, UserIDReadAction int64, LastTouchedReadAction int64, MonotonicReadAction bool, DescriptionReadAction string, ReminderReadAction pb.Reminder) (*pb.Action, error) {
 	request := pb.Action{
-		ID:     IDReadAction,
-		Name:   NameReadAction,
-		UserID: UserIDReadAction,
+		ID:          IDReadAction,
+		Name:        NameReadAction,
+		UserID:      UserIDReadAction,
+		LastTouched: LastTouchedReadAction,
+		Monotonic:   MonotonicReadAction,
+		Description: DescriptionReadAction,
+		Reminder:    &ReminderReadAction,
 	}
 	return &request, nil
 }
 
 // ReadActions implements Service.
-func ReadActions(UserIDReadActions int64) (*pb.User, error) {
-	request := pb.User{
-		UserID: UserIDReadActions,
+func ReadActions(UserIDReadActions int64, MinOccurrencesReadActions int64, OrderByReadActions string) (*pb.ReadActionsRequest, error) {
+	request := pb.ReadActionsRequest{
+		UserID:         UserIDReadActions,
+		MinOccurrences: MinOccurrencesReadActions,
+		OrderBy:        OrderByReadActions,
 	}
 	return &request, nil
 }
@@ -56,11 +69,135 @@ func ReadOccurrencesByDate(ActionIDReadOccurrencesByDate int64, StartDateReadOcc
 }
 
 // ReadOccurrences implements Service.
-func ReadOccurrences(IDReadOccurrences int64, NameReadOccurrences string, UserIDReadOccurrences int64) (*pb.Action, error) {
+func ReadOccurrences(IDReadOccurrences int64, NameReadOccurrences string, UserIDReadOccurrences int64, LastTouchedReadOccurrences int64, MonotonicReadOccurrences bool, DescriptionReadOccurrences string, ReminderReadOccurrences pb.Reminder) (*pb.Action, error) {
 	request := pb.Action{
-		ID:     IDReadOccurrences,
-		Name:   NameReadOccurrences,
-		UserID: UserIDReadOccurrences,
+		ID:          IDReadOccurrences,
+		Name:        NameReadOccurrences,
+		UserID:      UserIDReadOccurrences,
+		LastTouched: LastTouchedReadOccurrences,
+		Monotonic:   MonotonicReadOccurrences,
+		Description: DescriptionReadOccurrences,
+		Reminder:    &ReminderReadOccurrences,
+	}
+	return &request, nil
+}
+
+// ReconcileOccurrences implements Service.
+func ReconcileOccurrences(UserIDReconcileOccurrences int64, ActionIDReconcileOccurrences int64, OccurrenceIDsReconcileOccurrences []int64) (*pb.ReconcileOccurrencesRequest, error) {
+	request := pb.ReconcileOccurrencesRequest{
+		UserID:        UserIDReconcileOccurrences,
+		ActionID:      ActionIDReconcileOccurrences,
+		OccurrenceIDs: OccurrenceIDsReconcileOccurrences,
+	}
+	return &request, nil
+}
+
+// TouchAction implements Service.
+func TouchAction(IDTouchAction int64, NameTouchAction string, UserIDTouchAction int64, LastTouchedTouchAction int64, MonotonicTouchAction bool, DescriptionTouchAction string, ReminderTouchAction pb.Reminder) (*pb.Action, error) {
+	request := pb.Action{
+		ID:          IDTouchAction,
+		Name:        NameTouchAction,
+		UserID:      UserIDTouchAction,
+		LastTouched: LastTouchedTouchAction,
+		Monotonic:   MonotonicTouchAction,
+		Description: DescriptionTouchAction,
+		Reminder:    &ReminderTouchAction,
+	}
+	return &request, nil
+}
+
+// CloneActions implements Service.
+func CloneActions(UserIDCloneActions int64, SourceUserIDCloneActions int64) (*pb.CloneActionsRequest, error) {
+	request := pb.CloneActionsRequest{
+		UserID:       UserIDCloneActions,
+		SourceUserID: SourceUserIDCloneActions,
+	}
+	return &request, nil
+}
+
+// ReadFirstOccurrence implements Service.
+func ReadFirstOccurrence(UserIDReadFirstOccurrence int64) (*pb.User, error) {
+	request := pb.User{
+		UserID: UserIDReadFirstOccurrence,
+	}
+	return &request, nil
+}
+
+// ReadDaySummary implements Service.
+func ReadDaySummary(UserIDReadDaySummary int64, DateReadDaySummary string, TimezoneReadDaySummary string) (*pb.DaySummaryRequest, error) {
+	request := pb.DaySummaryRequest{
+		UserID:   UserIDReadDaySummary,
+		Date:     DateReadDaySummary,
+		Timezone: TimezoneReadDaySummary,
+	}
+	return &request, nil
+}
+
+// ShiftOccurrences implements Service.
+func ShiftOccurrences(UserIDShiftOccurrences int64, ActionIDShiftOccurrences int64, OffsetShiftOccurrences string, ConfirmShiftOccurrences bool) (*pb.ShiftOccurrencesRequest, error) {
+	request := pb.ShiftOccurrencesRequest{
+		UserID:   UserIDShiftOccurrences,
+		ActionID: ActionIDShiftOccurrences,
+		Offset:   OffsetShiftOccurrences,
+		Confirm:  ConfirmShiftOccurrences,
+	}
+	return &request, nil
+}
+
+// TransferAction implements Service.
+func TransferAction(UserIDTransferAction int64, ActionIDTransferAction int64, NewUserIDTransferAction int64) (*pb.TransferActionRequest, error) {
+	request := pb.TransferActionRequest{
+		UserID:    UserIDTransferAction,
+		ActionID:  ActionIDTransferAction,
+		NewUserID: NewUserIDTransferAction,
+	}
+	return &request, nil
+}
+
+// ReadUnloggedActions implements Service.
+func ReadUnloggedActions(UserIDReadUnloggedActions int64, MinAgeDaysReadUnloggedActions int64) (*pb.ReadUnloggedActionsRequest, error) {
+	request := pb.ReadUnloggedActionsRequest{
+		UserID:     UserIDReadUnloggedActions,
+		MinAgeDays: MinAgeDaysReadUnloggedActions,
+	}
+	return &request, nil
+}
+
+// ReadDueReminders implements Service.
+func ReadDueReminders(UserIDReadDueReminders int64, TimeReadDueReminders string) (*pb.DueRemindersRequest, error) {
+	request := pb.DueRemindersRequest{
+		UserID: UserIDReadDueReminders,
+		Time:   TimeReadDueReminders,
+	}
+	return &request, nil
+}
+
+// ReadOccurrencesMulti implements Service.
+func ReadOccurrencesMulti(UserIDReadOccurrencesMulti int64, ActionIDsReadOccurrencesMulti []int64, StartReadOccurrencesMulti string, EndReadOccurrencesMulti string) (*pb.OccurrencesMultiRequest, error) {
+	request := pb.OccurrencesMultiRequest{
+		UserID:    UserIDReadOccurrencesMulti,
+		ActionIDs: ActionIDsReadOccurrencesMulti,
+		Start:     StartReadOccurrencesMulti,
+		End:       EndReadOccurrencesMulti,
+	}
+	return &request, nil
+}
+
+// CompactOccurrences implements Service.
+func CompactOccurrences(UserIDCompactOccurrences int64, ActionIDCompactOccurrences int64, TimezoneCompactOccurrences string, DryRunCompactOccurrences bool) (*pb.CompactOccurrencesRequest, error) {
+	request := pb.CompactOccurrencesRequest{
+		UserID:   UserIDCompactOccurrences,
+		ActionID: ActionIDCompactOccurrences,
+		Timezone: TimezoneCompactOccurrences,
+		DryRun:   DryRunCompactOccurrences,
+	}
+	return &request, nil
+}
+
+// ReadUserUsage implements Service.
+func ReadUserUsage(UserIDReadUserUsage int64) (*pb.User, error) {
+	request := pb.User{
+		UserID: UserIDReadUserUsage,
 	}
 	return &request, nil
 }
@@ -113,6 +113,162 @@ func New(conn *grpc.ClientConn, options ...ClientOption) (pb.AmbitionServer, err
 		).Endpoint()
 	}
 
+	var reconcileoccurrencesEndpoint endpoint.Endpoint
+	{
+		reconcileoccurrencesEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"ReconcileOccurrences",
+			EncodeGRPCReconcileOccurrencesRequest,
+			DecodeGRPCReconcileOccurrencesResponse,
+			pb.ReconcileOccurrencesResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var touchactionEndpoint endpoint.Endpoint
+	{
+		touchactionEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"TouchAction",
+			EncodeGRPCTouchActionRequest,
+			DecodeGRPCTouchActionResponse,
+			pb.Action{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var cloneactionsEndpoint endpoint.Endpoint
+	{
+		cloneactionsEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"CloneActions",
+			EncodeGRPCCloneActionsRequest,
+			DecodeGRPCCloneActionsResponse,
+			pb.ActionsResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var readfirstoccurrenceEndpoint endpoint.Endpoint
+	{
+		readfirstoccurrenceEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"ReadFirstOccurrence",
+			EncodeGRPCReadFirstOccurrenceRequest,
+			DecodeGRPCReadFirstOccurrenceResponse,
+			pb.Occurrence{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var readdaysummaryEndpoint endpoint.Endpoint
+	{
+		readdaysummaryEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"ReadDaySummary",
+			EncodeGRPCReadDaySummaryRequest,
+			DecodeGRPCReadDaySummaryResponse,
+			pb.DaySummaryResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var shiftoccurrencesEndpoint endpoint.Endpoint
+	{
+		shiftoccurrencesEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"ShiftOccurrences",
+			EncodeGRPCShiftOccurrencesRequest,
+			DecodeGRPCShiftOccurrencesResponse,
+			pb.ShiftOccurrencesResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var transferactionEndpoint endpoint.Endpoint
+	{
+		transferactionEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"TransferAction",
+			EncodeGRPCTransferActionRequest,
+			DecodeGRPCTransferActionResponse,
+			pb.Action{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var readunloggedactionsEndpoint endpoint.Endpoint
+	{
+		readunloggedactionsEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"ReadUnloggedActions",
+			EncodeGRPCReadUnloggedActionsRequest,
+			DecodeGRPCReadUnloggedActionsResponse,
+			pb.ActionsResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var readdueremindersEndpoint endpoint.Endpoint
+	{
+		readdueremindersEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"ReadDueReminders",
+			EncodeGRPCReadDueRemindersRequest,
+			DecodeGRPCReadDueRemindersResponse,
+			pb.ActionsResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var readoccurrencesmultiEndpoint endpoint.Endpoint
+	{
+		readoccurrencesmultiEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"ReadOccurrencesMulti",
+			EncodeGRPCReadOccurrencesMultiRequest,
+			DecodeGRPCReadOccurrencesMultiResponse,
+			pb.OccurrencesMultiResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var compactoccurrencesEndpoint endpoint.Endpoint
+	{
+		compactoccurrencesEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"CompactOccurrences",
+			EncodeGRPCCompactOccurrencesRequest,
+			DecodeGRPCCompactOccurrencesResponse,
+			pb.CompactOccurrencesResponse{},
+			clientOptions...,
+		).Endpoint()
+	}
+
+	var readuserusageEndpoint endpoint.Endpoint
+	{
+		readuserusageEndpoint = grpctransport.NewClient(
+			conn,
+			"ambition.Ambition",
+			"ReadUserUsage",
+			EncodeGRPCReadUserUsageRequest,
+			DecodeGRPCReadUserUsageResponse,
+			pb.UserUsage{},
+			clientOptions...,
+		).Endpoint()
+	}
+
 	return svc.Endpoints{
 		CreateActionEndpoint:          createactionEndpoint,
 		CreateOccurrenceEndpoint:      createoccurrenceEndpoint,
@@ -120,6 +276,18 @@ func New(conn *grpc.ClientConn, options ...ClientOption) (pb.AmbitionServer, err
 		ReadActionsEndpoint:           readactionsEndpoint,
 		ReadOccurrencesByDateEndpoint: readoccurrencesbydateEndpoint,
 		ReadOccurrencesEndpoint:       readoccurrencesEndpoint,
+		ReconcileOccurrencesEndpoint:  reconcileoccurrencesEndpoint,
+		TouchActionEndpoint:           touchactionEndpoint,
+		CloneActionsEndpoint:          cloneactionsEndpoint,
+		ReadFirstOccurrenceEndpoint:   readfirstoccurrenceEndpoint,
+		ReadDaySummaryEndpoint:        readdaysummaryEndpoint,
+		ShiftOccurrencesEndpoint:      shiftoccurrencesEndpoint,
+		TransferActionEndpoint:        transferactionEndpoint,
+		ReadUnloggedActionsEndpoint:   readunloggedactionsEndpoint,
+		ReadDueRemindersEndpoint:      readdueremindersEndpoint,
+		ReadOccurrencesMultiEndpoint:  readoccurrencesmultiEndpoint,
+		CompactOccurrencesEndpoint:    compactoccurrencesEndpoint,
+		ReadUserUsageEndpoint:         readuserusageEndpoint,
 	}, nil
 }
 
@@ -167,6 +335,90 @@ func DecodeGRPCReadOccurrencesResponse(_ context.Context, grpcReply interface{})
 	return reply, nil
 }
 
+// DecodeGRPCReconcileOccurrencesResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC reconcileoccurrences reply to a user-domain reconcileoccurrences response. Primarily useful in a client.
+func DecodeGRPCReconcileOccurrencesResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ReconcileOccurrencesResponse)
+	return reply, nil
+}
+
+// DecodeGRPCTouchActionResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC touchaction reply to a user-domain touchaction response. Primarily useful in a client.
+func DecodeGRPCTouchActionResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.Action)
+	return reply, nil
+}
+
+// DecodeGRPCCloneActionsResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC cloneactions reply to a user-domain cloneactions response. Primarily useful in a client.
+func DecodeGRPCCloneActionsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ActionsResponse)
+	return reply, nil
+}
+
+// DecodeGRPCReadFirstOccurrenceResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC readfirstoccurrence reply to a user-domain readfirstoccurrence response. Primarily useful in a client.
+func DecodeGRPCReadFirstOccurrenceResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.Occurrence)
+	return reply, nil
+}
+
+// DecodeGRPCReadDaySummaryResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC readdaysummary reply to a user-domain readdaysummary response. Primarily useful in a client.
+func DecodeGRPCReadDaySummaryResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.DaySummaryResponse)
+	return reply, nil
+}
+
+// DecodeGRPCShiftOccurrencesResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC shiftoccurrences reply to a user-domain shiftoccurrences response. Primarily useful in a client.
+func DecodeGRPCShiftOccurrencesResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ShiftOccurrencesResponse)
+	return reply, nil
+}
+
+// DecodeGRPCTransferActionResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC transferaction reply to a user-domain transferaction response. Primarily useful in a client.
+func DecodeGRPCTransferActionResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.Action)
+	return reply, nil
+}
+
+// DecodeGRPCReadUnloggedActionsResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC readunloggedactions reply to a user-domain readunloggedactions response. Primarily useful in a client.
+func DecodeGRPCReadUnloggedActionsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ActionsResponse)
+	return reply, nil
+}
+
+// DecodeGRPCReadDueRemindersResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC readduereminders reply to a user-domain readduereminders response. Primarily useful in a client.
+func DecodeGRPCReadDueRemindersResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ActionsResponse)
+	return reply, nil
+}
+
+// DecodeGRPCReadOccurrencesMultiResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC readoccurrencesmulti reply to a user-domain readoccurrencesmulti response. Primarily useful in a client.
+func DecodeGRPCReadOccurrencesMultiResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.OccurrencesMultiResponse)
+	return reply, nil
+}
+
+// DecodeGRPCCompactOccurrencesResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC compactoccurrences reply to a user-domain compactoccurrences response. Primarily useful in a client.
+func DecodeGRPCCompactOccurrencesResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.CompactOccurrencesResponse)
+	return reply, nil
+}
+
+// DecodeGRPCReadUserUsageResponse is a transport/grpc.DecodeResponseFunc that converts a
+// gRPC readuserusage reply to a user-domain readuserusage response. Primarily useful in a client.
+func DecodeGRPCReadUserUsageResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.UserUsage)
+	return reply, nil
+}
+
 // GRPC Client Encode
 
 // EncodeGRPCCreateActionRequest is a transport/grpc.EncodeRequestFunc that converts a
@@ -193,7 +445,7 @@ func EncodeGRPCReadActionRequest(_ context.Context, request interface{}) (interf
 // EncodeGRPCReadActionsRequest is a transport/grpc.EncodeRequestFunc that converts a
 // user-domain readactions request to a gRPC readactions request. Primarily useful in a client.
 func EncodeGRPCReadActionsRequest(_ context.Context, request interface{}) (interface{}, error) {
-	req := request.(*pb.User)
+	req := request.(*pb.ReadActionsRequest)
 	return req, nil
 }
 
@@ -211,6 +463,90 @@ func EncodeGRPCReadOccurrencesRequest(_ context.Context, request interface{}) (i
 	return req, nil
 }
 
+// EncodeGRPCReconcileOccurrencesRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain reconcileoccurrences request to a gRPC reconcileoccurrences request. Primarily useful in a client.
+func EncodeGRPCReconcileOccurrencesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.ReconcileOccurrencesRequest)
+	return req, nil
+}
+
+// EncodeGRPCTouchActionRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain touchaction request to a gRPC touchaction request. Primarily useful in a client.
+func EncodeGRPCTouchActionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.Action)
+	return req, nil
+}
+
+// EncodeGRPCCloneActionsRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain cloneactions request to a gRPC cloneactions request. Primarily useful in a client.
+func EncodeGRPCCloneActionsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CloneActionsRequest)
+	return req, nil
+}
+
+// EncodeGRPCReadFirstOccurrenceRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain readfirstoccurrence request to a gRPC readfirstoccurrence request. Primarily useful in a client.
+func EncodeGRPCReadFirstOccurrenceRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.User)
+	return req, nil
+}
+
+// EncodeGRPCReadDaySummaryRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain readdaysummary request to a gRPC readdaysummary request. Primarily useful in a client.
+func EncodeGRPCReadDaySummaryRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.DaySummaryRequest)
+	return req, nil
+}
+
+// EncodeGRPCShiftOccurrencesRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain shiftoccurrences request to a gRPC shiftoccurrences request. Primarily useful in a client.
+func EncodeGRPCShiftOccurrencesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.ShiftOccurrencesRequest)
+	return req, nil
+}
+
+// EncodeGRPCTransferActionRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain transferaction request to a gRPC transferaction request. Primarily useful in a client.
+func EncodeGRPCTransferActionRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.TransferActionRequest)
+	return req, nil
+}
+
+// EncodeGRPCReadUnloggedActionsRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain readunloggedactions request to a gRPC readunloggedactions request. Primarily useful in a client.
+func EncodeGRPCReadUnloggedActionsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.ReadUnloggedActionsRequest)
+	return req, nil
+}
+
+// EncodeGRPCReadDueRemindersRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain readduereminders request to a gRPC readduereminders request. Primarily useful in a client.
+func EncodeGRPCReadDueRemindersRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.DueRemindersRequest)
+	return req, nil
+}
+
+// EncodeGRPCReadOccurrencesMultiRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain readoccurrencesmulti request to a gRPC readoccurrencesmulti request. Primarily useful in a client.
+func EncodeGRPCReadOccurrencesMultiRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.OccurrencesMultiRequest)
+	return req, nil
+}
+
+// EncodeGRPCCompactOccurrencesRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain compactoccurrences request to a gRPC compactoccurrences request. Primarily useful in a client.
+func EncodeGRPCCompactOccurrencesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.CompactOccurrencesRequest)
+	return req, nil
+}
+
+// EncodeGRPCReadUserUsageRequest is a transport/grpc.EncodeRequestFunc that converts a
+// user-domain readuserusage request to a gRPC readuserusage request. Primarily useful in a client.
+func EncodeGRPCReadUserUsageRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*pb.User)
+	return req, nil
+}
+
 type clientConfig struct {
 	headers []string
 }
@@ -38,6 +38,18 @@ type Endpoints struct {
 	ReadActionsEndpoint           endpoint.Endpoint
 	ReadOccurrencesByDateEndpoint endpoint.Endpoint
 	ReadOccurrencesEndpoint       endpoint.Endpoint
+	ReconcileOccurrencesEndpoint  endpoint.Endpoint
+	TouchActionEndpoint           endpoint.Endpoint
+	CloneActionsEndpoint          endpoint.Endpoint
+	ReadFirstOccurrenceEndpoint   endpoint.Endpoint
+	ReadDaySummaryEndpoint        endpoint.Endpoint
+	ShiftOccurrencesEndpoint      endpoint.Endpoint
+	TransferActionEndpoint        endpoint.Endpoint
+	ReadUnloggedActionsEndpoint   endpoint.Endpoint
+	ReadDueRemindersEndpoint      endpoint.Endpoint
+	ReadOccurrencesMultiEndpoint  endpoint.Endpoint
+	CompactOccurrencesEndpoint    endpoint.Endpoint
+	ReadUserUsageEndpoint         endpoint.Endpoint
 }
 
 // Endpoints
@@ -66,7 +78,7 @@ func (e Endpoints) ReadAction(ctx context.Context, in *pb.Action) (*pb.Action, e
 	return response.(*pb.Action), nil
 }
 
-func (e Endpoints) ReadActions(ctx context.Context, in *pb.User) (*pb.ActionsResponse, error) {
+func (e Endpoints) ReadActions(ctx context.Context, in *pb.ReadActionsRequest) (*pb.ActionsResponse, error) {
 	response, err := e.ReadActionsEndpoint(ctx, in)
 	if err != nil {
 		return nil, err
@@ -90,6 +102,102 @@ func (e Endpoints) ReadOccurrences(ctx context.Context, in *pb.Action) (*pb.Occu
 	return response.(*pb.OccurrencesResponse), nil
 }
 
+func (e Endpoints) ReconcileOccurrences(ctx context.Context, in *pb.ReconcileOccurrencesRequest) (*pb.ReconcileOccurrencesResponse, error) {
+	response, err := e.ReconcileOccurrencesEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.ReconcileOccurrencesResponse), nil
+}
+
+func (e Endpoints) TouchAction(ctx context.Context, in *pb.Action) (*pb.Action, error) {
+	response, err := e.TouchActionEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.Action), nil
+}
+
+func (e Endpoints) CloneActions(ctx context.Context, in *pb.CloneActionsRequest) (*pb.ActionsResponse, error) {
+	response, err := e.CloneActionsEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.ActionsResponse), nil
+}
+
+func (e Endpoints) ReadFirstOccurrence(ctx context.Context, in *pb.User) (*pb.Occurrence, error) {
+	response, err := e.ReadFirstOccurrenceEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.Occurrence), nil
+}
+
+func (e Endpoints) ReadDaySummary(ctx context.Context, in *pb.DaySummaryRequest) (*pb.DaySummaryResponse, error) {
+	response, err := e.ReadDaySummaryEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.DaySummaryResponse), nil
+}
+
+func (e Endpoints) ShiftOccurrences(ctx context.Context, in *pb.ShiftOccurrencesRequest) (*pb.ShiftOccurrencesResponse, error) {
+	response, err := e.ShiftOccurrencesEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.ShiftOccurrencesResponse), nil
+}
+
+func (e Endpoints) TransferAction(ctx context.Context, in *pb.TransferActionRequest) (*pb.Action, error) {
+	response, err := e.TransferActionEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.Action), nil
+}
+
+func (e Endpoints) ReadUnloggedActions(ctx context.Context, in *pb.ReadUnloggedActionsRequest) (*pb.ActionsResponse, error) {
+	response, err := e.ReadUnloggedActionsEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.ActionsResponse), nil
+}
+
+func (e Endpoints) ReadDueReminders(ctx context.Context, in *pb.DueRemindersRequest) (*pb.ActionsResponse, error) {
+	response, err := e.ReadDueRemindersEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.ActionsResponse), nil
+}
+
+func (e Endpoints) ReadOccurrencesMulti(ctx context.Context, in *pb.OccurrencesMultiRequest) (*pb.OccurrencesMultiResponse, error) {
+	response, err := e.ReadOccurrencesMultiEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.OccurrencesMultiResponse), nil
+}
+
+func (e Endpoints) CompactOccurrences(ctx context.Context, in *pb.CompactOccurrencesRequest) (*pb.CompactOccurrencesResponse, error) {
+	response, err := e.CompactOccurrencesEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.CompactOccurrencesResponse), nil
+}
+
+func (e Endpoints) ReadUserUsage(ctx context.Context, in *pb.User) (*pb.UserUsage, error) {
+	response, err := e.ReadUserUsageEndpoint(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return response.(*pb.UserUsage), nil
+}
+
 // Make Endpoints
 
 func MakeCreateActionEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
@@ -127,7 +235,7 @@ func MakeReadActionEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
 
 func MakeReadActionsEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-		req := request.(*pb.User)
+		req := request.(*pb.ReadActionsRequest)
 		v, err := s.ReadActions(ctx, req)
 		if err != nil {
 			return nil, err
@@ -158,6 +266,138 @@ func MakeReadOccurrencesEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
 	}
 }
 
+func MakeReconcileOccurrencesEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.ReconcileOccurrencesRequest)
+		v, err := s.ReconcileOccurrences(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeTouchActionEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.Action)
+		v, err := s.TouchAction(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeCloneActionsEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.CloneActionsRequest)
+		v, err := s.CloneActions(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeReadFirstOccurrenceEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.User)
+		v, err := s.ReadFirstOccurrence(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeReadDaySummaryEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.DaySummaryRequest)
+		v, err := s.ReadDaySummary(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeShiftOccurrencesEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.ShiftOccurrencesRequest)
+		v, err := s.ShiftOccurrences(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeTransferActionEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.TransferActionRequest)
+		v, err := s.TransferAction(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeReadUnloggedActionsEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.ReadUnloggedActionsRequest)
+		v, err := s.ReadUnloggedActions(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeReadDueRemindersEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.DueRemindersRequest)
+		v, err := s.ReadDueReminders(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeReadOccurrencesMultiEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.OccurrencesMultiRequest)
+		v, err := s.ReadOccurrencesMulti(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeCompactOccurrencesEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.CompactOccurrencesRequest)
+		v, err := s.CompactOccurrences(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
+func MakeReadUserUsageEndpoint(s pb.AmbitionServer) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req := request.(*pb.User)
+		v, err := s.ReadUserUsage(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return v, nil
+	}
+}
+
 // WrapAllExcept wraps each Endpoint field of struct Endpoints with a
 // go-kit/kit/endpoint.Middleware.
 // Use this for applying a set of middlewares to every endpoint in the service.
@@ -171,6 +411,18 @@ func (e *Endpoints) WrapAllExcept(middleware endpoint.Middleware, excluded ...st
 		"ReadActions":           struct{}{},
 		"ReadOccurrencesByDate": struct{}{},
 		"ReadOccurrences":       struct{}{},
+		"ReconcileOccurrences":  struct{}{},
+		"TouchAction":           struct{}{},
+		"CloneActions":          struct{}{},
+		"ReadFirstOccurrence":   struct{}{},
+		"ReadDaySummary":        struct{}{},
+		"ShiftOccurrences":      struct{}{},
+		"TransferAction":        struct{}{},
+		"ReadUnloggedActions":   struct{}{},
+		"ReadDueReminders":      struct{}{},
+		"ReadOccurrencesMulti":  struct{}{},
+		"CompactOccurrences":    struct{}{},
+		"ReadUserUsage":         struct{}{},
 	}
 
 	for _, ex := range excluded {
@@ -199,5 +451,41 @@ func (e *Endpoints) WrapAllExcept(middleware endpoint.Middleware, excluded ...st
 		if inc == "ReadOccurrences" {
 			e.ReadOccurrencesEndpoint = middleware(e.ReadOccurrencesEndpoint)
 		}
+		if inc == "ReconcileOccurrences" {
+			e.ReconcileOccurrencesEndpoint = middleware(e.ReconcileOccurrencesEndpoint)
+		}
+		if inc == "TouchAction" {
+			e.TouchActionEndpoint = middleware(e.TouchActionEndpoint)
+		}
+		if inc == "CloneActions" {
+			e.CloneActionsEndpoint = middleware(e.CloneActionsEndpoint)
+		}
+		if inc == "ReadFirstOccurrence" {
+			e.ReadFirstOccurrenceEndpoint = middleware(e.ReadFirstOccurrenceEndpoint)
+		}
+		if inc == "ReadDaySummary" {
+			e.ReadDaySummaryEndpoint = middleware(e.ReadDaySummaryEndpoint)
+		}
+		if inc == "ShiftOccurrences" {
+			e.ShiftOccurrencesEndpoint = middleware(e.ShiftOccurrencesEndpoint)
+		}
+		if inc == "TransferAction" {
+			e.TransferActionEndpoint = middleware(e.TransferActionEndpoint)
+		}
+		if inc == "ReadUnloggedActions" {
+			e.ReadUnloggedActionsEndpoint = middleware(e.ReadUnloggedActionsEndpoint)
+		}
+		if inc == "ReadDueReminders" {
+			e.ReadDueRemindersEndpoint = middleware(e.ReadDueRemindersEndpoint)
+		}
+		if inc == "ReadOccurrencesMulti" {
+			e.ReadOccurrencesMultiEndpoint = middleware(e.ReadOccurrencesMultiEndpoint)
+		}
+		if inc == "CompactOccurrences" {
+			e.CompactOccurrencesEndpoint = middleware(e.CompactOccurrencesEndpoint)
+		}
+		if inc == "ReadUserUsage" {
+			e.ReadUserUsageEndpoint = middleware(e.ReadUserUsageEndpoint)
+		}
 	}
 }
@@ -48,6 +48,18 @@ func Run(cfg Config, logger log.Logger) {
 		readactionsEndpoint           = svc.MakeReadActionsEndpoint(service)
 		readoccurrencesbydateEndpoint = svc.MakeReadOccurrencesByDateEndpoint(service)
 		readoccurrencesEndpoint       = svc.MakeReadOccurrencesEndpoint(service)
+		reconcileoccurrencesEndpoint  = svc.MakeReconcileOccurrencesEndpoint(service)
+		touchactionEndpoint           = svc.MakeTouchActionEndpoint(service)
+		cloneactionsEndpoint          = svc.MakeCloneActionsEndpoint(service)
+		readfirstoccurrenceEndpoint   = svc.MakeReadFirstOccurrenceEndpoint(service)
+		readdaysummaryEndpoint        = svc.MakeReadDaySummaryEndpoint(service)
+		shiftoccurrencesEndpoint      = svc.MakeShiftOccurrencesEndpoint(service)
+		transferactionEndpoint        = svc.MakeTransferActionEndpoint(service)
+		readunloggedactionsEndpoint   = svc.MakeReadUnloggedActionsEndpoint(service)
+		readdueremindersEndpoint      = svc.MakeReadDueRemindersEndpoint(service)
+		readoccurrencesmultiEndpoint  = svc.MakeReadOccurrencesMultiEndpoint(service)
+		compactoccurrencesEndpoint    = svc.MakeCompactOccurrencesEndpoint(service)
+		readuserusageEndpoint         = svc.MakeReadUserUsageEndpoint(service)
 	)
 
 	endpoints := svc.Endpoints{
@@ -57,6 +69,18 @@ func Run(cfg Config, logger log.Logger) {
 		ReadActionsEndpoint:           readactionsEndpoint,
 		ReadOccurrencesByDateEndpoint: readoccurrencesbydateEndpoint,
 		ReadOccurrencesEndpoint:       readoccurrencesEndpoint,
+		ReconcileOccurrencesEndpoint:  reconcileoccurrencesEndpoint,
+		TouchActionEndpoint:           touchactionEndpoint,
+		CloneActionsEndpoint:          cloneactionsEndpoint,
+		ReadFirstOccurrenceEndpoint:   readfirstoccurrenceEndpoint,
+		ReadDaySummaryEndpoint:        readdaysummaryEndpoint,
+		ShiftOccurrencesEndpoint:      shiftoccurrencesEndpoint,
+		TransferActionEndpoint:        transferactionEndpoint,
+		ReadUnloggedActionsEndpoint:   readunloggedactionsEndpoint,
+		ReadDueRemindersEndpoint:      readdueremindersEndpoint,
+		ReadOccurrencesMultiEndpoint:  readoccurrencesmultiEndpoint,
+		CompactOccurrencesEndpoint:    compactoccurrencesEndpoint,
+		ReadUserUsageEndpoint:         readuserusageEndpoint,
 	}
 
 	// Wrap selected Endpoints with middlewares. See middlewares/endpoints.go
@@ -88,6 +112,8 @@ func Run(cfg Config, logger log.Logger) {
 	go func() {
 		logger := log.NewContext(logger).With("transport", "HTTP")
 		h := svc.MakeHTTPHandler(ctx, endpoints, logger)
+		// Wrap the HTTP handler with middlewares. See middlewares/http.go
+		h = middlewares.WrapHTTPHandler(h)
 		logger.Log("addr", cfg.HTTPAddr)
 		errc <- http.ListenAndServe(cfg.HTTPAddr, h)
 	}()
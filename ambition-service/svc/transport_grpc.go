@@ -69,6 +69,90 @@ func MakeGRPCServer(ctx context.Context, endpoints Endpoints) pb.AmbitionServer
 			EncodeGRPCReadOccurrencesResponse,
 			serverOptions...,
 		),
+		reconcileoccurrences: grpctransport.NewServer(
+			ctx,
+			endpoints.ReconcileOccurrencesEndpoint,
+			DecodeGRPCReconcileOccurrencesRequest,
+			EncodeGRPCReconcileOccurrencesResponse,
+			serverOptions...,
+		),
+		touchaction: grpctransport.NewServer(
+			ctx,
+			endpoints.TouchActionEndpoint,
+			DecodeGRPCTouchActionRequest,
+			EncodeGRPCTouchActionResponse,
+			serverOptions...,
+		),
+		cloneactions: grpctransport.NewServer(
+			ctx,
+			endpoints.CloneActionsEndpoint,
+			DecodeGRPCCloneActionsRequest,
+			EncodeGRPCCloneActionsResponse,
+			serverOptions...,
+		),
+		readfirstoccurrence: grpctransport.NewServer(
+			ctx,
+			endpoints.ReadFirstOccurrenceEndpoint,
+			DecodeGRPCReadFirstOccurrenceRequest,
+			EncodeGRPCReadFirstOccurrenceResponse,
+			serverOptions...,
+		),
+		readdaysummary: grpctransport.NewServer(
+			ctx,
+			endpoints.ReadDaySummaryEndpoint,
+			DecodeGRPCReadDaySummaryRequest,
+			EncodeGRPCReadDaySummaryResponse,
+			serverOptions...,
+		),
+		shiftoccurrences: grpctransport.NewServer(
+			ctx,
+			endpoints.ShiftOccurrencesEndpoint,
+			DecodeGRPCShiftOccurrencesRequest,
+			EncodeGRPCShiftOccurrencesResponse,
+			serverOptions...,
+		),
+		transferaction: grpctransport.NewServer(
+			ctx,
+			endpoints.TransferActionEndpoint,
+			DecodeGRPCTransferActionRequest,
+			EncodeGRPCTransferActionResponse,
+			serverOptions...,
+		),
+		readunloggedactions: grpctransport.NewServer(
+			ctx,
+			endpoints.ReadUnloggedActionsEndpoint,
+			DecodeGRPCReadUnloggedActionsRequest,
+			EncodeGRPCReadUnloggedActionsResponse,
+			serverOptions...,
+		),
+		readduereminders: grpctransport.NewServer(
+			ctx,
+			endpoints.ReadDueRemindersEndpoint,
+			DecodeGRPCReadDueRemindersRequest,
+			EncodeGRPCReadDueRemindersResponse,
+			serverOptions...,
+		),
+		readoccurrencesmulti: grpctransport.NewServer(
+			ctx,
+			endpoints.ReadOccurrencesMultiEndpoint,
+			DecodeGRPCReadOccurrencesMultiRequest,
+			EncodeGRPCReadOccurrencesMultiResponse,
+			serverOptions...,
+		),
+		compactoccurrences: grpctransport.NewServer(
+			ctx,
+			endpoints.CompactOccurrencesEndpoint,
+			DecodeGRPCCompactOccurrencesRequest,
+			EncodeGRPCCompactOccurrencesResponse,
+			serverOptions...,
+		),
+		readuserusage: grpctransport.NewServer(
+			ctx,
+			endpoints.ReadUserUsageEndpoint,
+			DecodeGRPCReadUserUsageRequest,
+			EncodeGRPCReadUserUsageResponse,
+			serverOptions...,
+		),
 	}
 }
 
@@ -80,6 +164,18 @@ type grpcServer struct {
 	readactions           grpctransport.Handler
 	readoccurrencesbydate grpctransport.Handler
 	readoccurrences       grpctransport.Handler
+	reconcileoccurrences  grpctransport.Handler
+	touchaction           grpctransport.Handler
+	cloneactions          grpctransport.Handler
+	readfirstoccurrence   grpctransport.Handler
+	readdaysummary        grpctransport.Handler
+	shiftoccurrences      grpctransport.Handler
+	transferaction        grpctransport.Handler
+	readunloggedactions   grpctransport.Handler
+	readduereminders      grpctransport.Handler
+	readoccurrencesmulti  grpctransport.Handler
+	compactoccurrences    grpctransport.Handler
+	readuserusage         grpctransport.Handler
 }
 
 // Methods for grpcServer to implement AmbitionServer interface
@@ -108,7 +204,7 @@ func (s *grpcServer) ReadAction(ctx context.Context, req *pb.Action) (*pb.Action
 	return rep.(*pb.Action), nil
 }
 
-func (s *grpcServer) ReadActions(ctx context.Context, req *pb.User) (*pb.ActionsResponse, error) {
+func (s *grpcServer) ReadActions(ctx context.Context, req *pb.ReadActionsRequest) (*pb.ActionsResponse, error) {
 	_, rep, err := s.readactions.ServeGRPC(ctx, req)
 	if err != nil {
 		return nil, err
@@ -132,6 +228,102 @@ func (s *grpcServer) ReadOccurrences(ctx context.Context, req *pb.Action) (*pb.O
 	return rep.(*pb.OccurrencesResponse), nil
 }
 
+func (s *grpcServer) ReconcileOccurrences(ctx context.Context, req *pb.ReconcileOccurrencesRequest) (*pb.ReconcileOccurrencesResponse, error) {
+	_, rep, err := s.reconcileoccurrences.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ReconcileOccurrencesResponse), nil
+}
+
+func (s *grpcServer) TouchAction(ctx context.Context, req *pb.Action) (*pb.Action, error) {
+	_, rep, err := s.touchaction.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Action), nil
+}
+
+func (s *grpcServer) CloneActions(ctx context.Context, req *pb.CloneActionsRequest) (*pb.ActionsResponse, error) {
+	_, rep, err := s.cloneactions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ActionsResponse), nil
+}
+
+func (s *grpcServer) ReadFirstOccurrence(ctx context.Context, req *pb.User) (*pb.Occurrence, error) {
+	_, rep, err := s.readfirstoccurrence.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Occurrence), nil
+}
+
+func (s *grpcServer) ReadDaySummary(ctx context.Context, req *pb.DaySummaryRequest) (*pb.DaySummaryResponse, error) {
+	_, rep, err := s.readdaysummary.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.DaySummaryResponse), nil
+}
+
+func (s *grpcServer) ShiftOccurrences(ctx context.Context, req *pb.ShiftOccurrencesRequest) (*pb.ShiftOccurrencesResponse, error) {
+	_, rep, err := s.shiftoccurrences.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ShiftOccurrencesResponse), nil
+}
+
+func (s *grpcServer) TransferAction(ctx context.Context, req *pb.TransferActionRequest) (*pb.Action, error) {
+	_, rep, err := s.transferaction.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.Action), nil
+}
+
+func (s *grpcServer) ReadUnloggedActions(ctx context.Context, req *pb.ReadUnloggedActionsRequest) (*pb.ActionsResponse, error) {
+	_, rep, err := s.readunloggedactions.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ActionsResponse), nil
+}
+
+func (s *grpcServer) ReadDueReminders(ctx context.Context, req *pb.DueRemindersRequest) (*pb.ActionsResponse, error) {
+	_, rep, err := s.readduereminders.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.ActionsResponse), nil
+}
+
+func (s *grpcServer) ReadOccurrencesMulti(ctx context.Context, req *pb.OccurrencesMultiRequest) (*pb.OccurrencesMultiResponse, error) {
+	_, rep, err := s.readoccurrencesmulti.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.OccurrencesMultiResponse), nil
+}
+
+func (s *grpcServer) CompactOccurrences(ctx context.Context, req *pb.CompactOccurrencesRequest) (*pb.CompactOccurrencesResponse, error) {
+	_, rep, err := s.compactoccurrences.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.CompactOccurrencesResponse), nil
+}
+
+func (s *grpcServer) ReadUserUsage(ctx context.Context, req *pb.User) (*pb.UserUsage, error) {
+	_, rep, err := s.readuserusage.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rep.(*pb.UserUsage), nil
+}
+
 // Server Decode
 
 // DecodeGRPCCreateActionRequest is a transport/grpc.DecodeRequestFunc that converts a
@@ -158,7 +350,7 @@ func DecodeGRPCReadActionRequest(_ context.Context, grpcReq interface{}) (interf
 // DecodeGRPCReadActionsRequest is a transport/grpc.DecodeRequestFunc that converts a
 // gRPC readactions request to a user-domain readactions request. Primarily useful in a server.
 func DecodeGRPCReadActionsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
-	req := grpcReq.(*pb.User)
+	req := grpcReq.(*pb.ReadActionsRequest)
 	return req, nil
 }
 
@@ -176,6 +368,90 @@ func DecodeGRPCReadOccurrencesRequest(_ context.Context, grpcReq interface{}) (i
 	return req, nil
 }
 
+// DecodeGRPCReconcileOccurrencesRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC reconcileoccurrences request to a user-domain reconcileoccurrences request. Primarily useful in a server.
+func DecodeGRPCReconcileOccurrencesRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ReconcileOccurrencesRequest)
+	return req, nil
+}
+
+// DecodeGRPCTouchActionRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC touchaction request to a user-domain touchaction request. Primarily useful in a server.
+func DecodeGRPCTouchActionRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.Action)
+	return req, nil
+}
+
+// DecodeGRPCCloneActionsRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC cloneactions request to a user-domain cloneactions request. Primarily useful in a server.
+func DecodeGRPCCloneActionsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CloneActionsRequest)
+	return req, nil
+}
+
+// DecodeGRPCReadFirstOccurrenceRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC readfirstoccurrence request to a user-domain readfirstoccurrence request. Primarily useful in a server.
+func DecodeGRPCReadFirstOccurrenceRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.User)
+	return req, nil
+}
+
+// DecodeGRPCReadDaySummaryRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC readdaysummary request to a user-domain readdaysummary request. Primarily useful in a server.
+func DecodeGRPCReadDaySummaryRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.DaySummaryRequest)
+	return req, nil
+}
+
+// DecodeGRPCShiftOccurrencesRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC shiftoccurrences request to a user-domain shiftoccurrences request. Primarily useful in a server.
+func DecodeGRPCShiftOccurrencesRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ShiftOccurrencesRequest)
+	return req, nil
+}
+
+// DecodeGRPCTransferActionRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC transferaction request to a user-domain transferaction request. Primarily useful in a server.
+func DecodeGRPCTransferActionRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.TransferActionRequest)
+	return req, nil
+}
+
+// DecodeGRPCReadUnloggedActionsRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC readunloggedactions request to a user-domain readunloggedactions request. Primarily useful in a server.
+func DecodeGRPCReadUnloggedActionsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ReadUnloggedActionsRequest)
+	return req, nil
+}
+
+// DecodeGRPCReadDueRemindersRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC readduereminders request to a user-domain readduereminders request. Primarily useful in a server.
+func DecodeGRPCReadDueRemindersRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.DueRemindersRequest)
+	return req, nil
+}
+
+// DecodeGRPCReadOccurrencesMultiRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC readoccurrencesmulti request to a user-domain readoccurrencesmulti request. Primarily useful in a server.
+func DecodeGRPCReadOccurrencesMultiRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.OccurrencesMultiRequest)
+	return req, nil
+}
+
+// DecodeGRPCCompactOccurrencesRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC compactoccurrences request to a user-domain compactoccurrences request. Primarily useful in a server.
+func DecodeGRPCCompactOccurrencesRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CompactOccurrencesRequest)
+	return req, nil
+}
+
+// DecodeGRPCReadUserUsageRequest is a transport/grpc.DecodeRequestFunc that converts a
+// gRPC readuserusage request to a user-domain readuserusage request. Primarily useful in a server.
+func DecodeGRPCReadUserUsageRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.User)
+	return req, nil
+}
+
 // Server Encode
 
 // EncodeGRPCCreateActionResponse is a transport/grpc.EncodeResponseFunc that converts a
@@ -220,6 +496,90 @@ func EncodeGRPCReadOccurrencesResponse(_ context.Context, response interface{})
 	return resp, nil
 }
 
+// EncodeGRPCReconcileOccurrencesResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain reconcileoccurrences response to a gRPC reconcileoccurrences reply. Primarily useful in a server.
+func EncodeGRPCReconcileOccurrencesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.ReconcileOccurrencesResponse)
+	return resp, nil
+}
+
+// EncodeGRPCTouchActionResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain touchaction response to a gRPC touchaction reply. Primarily useful in a server.
+func EncodeGRPCTouchActionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.Action)
+	return resp, nil
+}
+
+// EncodeGRPCCloneActionsResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain cloneactions response to a gRPC cloneactions reply. Primarily useful in a server.
+func EncodeGRPCCloneActionsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.ActionsResponse)
+	return resp, nil
+}
+
+// EncodeGRPCReadFirstOccurrenceResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain readfirstoccurrence response to a gRPC readfirstoccurrence reply. Primarily useful in a server.
+func EncodeGRPCReadFirstOccurrenceResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.Occurrence)
+	return resp, nil
+}
+
+// EncodeGRPCReadDaySummaryResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain readdaysummary response to a gRPC readdaysummary reply. Primarily useful in a server.
+func EncodeGRPCReadDaySummaryResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.DaySummaryResponse)
+	return resp, nil
+}
+
+// EncodeGRPCShiftOccurrencesResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain shiftoccurrences response to a gRPC shiftoccurrences reply. Primarily useful in a server.
+func EncodeGRPCShiftOccurrencesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.ShiftOccurrencesResponse)
+	return resp, nil
+}
+
+// EncodeGRPCTransferActionResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain transferaction response to a gRPC transferaction reply. Primarily useful in a server.
+func EncodeGRPCTransferActionResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.Action)
+	return resp, nil
+}
+
+// EncodeGRPCReadUnloggedActionsResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain readunloggedactions response to a gRPC readunloggedactions reply. Primarily useful in a server.
+func EncodeGRPCReadUnloggedActionsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.ActionsResponse)
+	return resp, nil
+}
+
+// EncodeGRPCReadDueRemindersResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain readduereminders response to a gRPC readduereminders reply. Primarily useful in a server.
+func EncodeGRPCReadDueRemindersResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.ActionsResponse)
+	return resp, nil
+}
+
+// EncodeGRPCReadOccurrencesMultiResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain readoccurrencesmulti response to a gRPC readoccurrencesmulti reply. Primarily useful in a server.
+func EncodeGRPCReadOccurrencesMultiResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.OccurrencesMultiResponse)
+	return resp, nil
+}
+
+// EncodeGRPCCompactOccurrencesResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain compactoccurrences response to a gRPC compactoccurrences reply. Primarily useful in a server.
+func EncodeGRPCCompactOccurrencesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.CompactOccurrencesResponse)
+	return resp, nil
+}
+
+// EncodeGRPCReadUserUsageResponse is a transport/grpc.EncodeResponseFunc that converts a
+// user-domain readuserusage response to a gRPC readuserusage reply. Primarily useful in a server.
+func EncodeGRPCReadUserUsageResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*pb.UserUsage)
+	return resp, nil
+}
+
 // Helpers
 
 func metadataToContext(ctx context.Context, md *metadata.MD) context.Context {
@@ -1,2 +1,3 @@
-CREATE TABLE actions(id SERIAL PRIMARY KEY, action_name varchar(255), set_id integer, user_id integer, trello_id varchar(255))
-CREATE TABLE occurrences(id SERIAL PRIMARY KEY, action_id integer, datetime varchar(255), data varchar(255))
+CREATE TABLE actions(id SERIAL PRIMARY KEY, action_name varchar(255), set_id integer, user_id integer, trello_id varchar(255), last_touched bigint NOT NULL DEFAULT 0, monotonic boolean NOT NULL DEFAULT 0, description varchar(2000) NOT NULL DEFAULT '', reminder varchar(1024) NOT NULL DEFAULT '', created_at bigint NOT NULL DEFAULT 0)
+CREATE TABLE occurrences(id SERIAL PRIMARY KEY, action_id integer, datetime varchar(255), data varchar(255), rating integer NOT NULL DEFAULT 0, geohash varchar(12) NOT NULL DEFAULT '')
+CREATE UNIQUE INDEX actions_user_name ON actions(user_id, action_name)
@@ -2,32 +2,192 @@ package mysql
 
 import (
 	"database/sql"
-	_ "github.com/go-sql-driver/mysql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
 	//"github.com/adamryman/db"
 
 	pb "github.com/adamryman/ambition-model/ambition-service"
+	"github.com/adamryman/ambition-model/dsn"
 )
 
-func Open(conn string) (*Database, error) {
+// ErrActionExists is returned by CreateAction when the user already has an
+// action with the same name.
+var ErrActionExists = errors.New("user already has an action with that name")
+
+// ErrActionNotFound is returned by ReadActionByNameAndUserID when the user has
+// no action with that name.
+var ErrActionNotFound = errors.New("user has no action with that name")
+
+// ErrActionNotOwned is returned by TransferAction when the action does not
+// belong to the user it is transferred from.
+var ErrActionNotOwned = errors.New("action is not owned by that user")
+
+// Open connects to the mysql database at conn, adding any columns its tables
+// are missing. If caseSensitiveNames is true action names that differ only in
+// case are treated as different names.
+func Open(conn string, caseSensitiveNames bool) (*Database, error) {
 	d, err := sql.Open("mysql", conn)
 	if err != nil {
-		return nil, errors.Wrapf(err, "cannot connect to mysql with %s", conn)
+		return nil, errors.Wrapf(err, "cannot connect to mysql with %s", dsn.Redact(conn))
+	}
+	if err := d.Ping(); err != nil {
+		return nil, errors.Wrapf(err, "cannot make initial database connection to %s", dsn.Redact(conn))
+	}
+
+	if err := setupDB(d, caseSensitiveNames); err != nil {
+		return nil, err
+	}
+
+	return &Database{db: d, caseSensitiveNames: caseSensitiveNames}, nil
+}
+
+// setupDB brings tables created by an older envscript/createTables.sql up to
+// date, as every query names the columns added since, and checks that the
+// unique action name index follows the name case policy.
+func setupDB(db *sql.DB, caseSensitiveNames bool) error {
+	err := addMissingColumns(db, "actions", [][2]string{
+		{"last_touched", "bigint NOT NULL DEFAULT 0"},
+		{"monotonic", "boolean NOT NULL DEFAULT 0"},
+		{"description", "varchar(2000) NOT NULL DEFAULT ''"},
+		{"reminder", "varchar(1024) NOT NULL DEFAULT ''"},
+		{"created_at", "bigint NOT NULL DEFAULT 0"},
+	})
+	if err != nil {
+		return err
+	}
+	err = addMissingColumns(db, "occurrences", [][2]string{
+		{"rating", "integer NOT NULL DEFAULT 0"},
+		{"geohash", "varchar(12) NOT NULL DEFAULT ''"},
+	})
+	if err != nil {
+		return err
+	}
+
+	// The unique index compares names with the column's collation, so it only
+	// agrees with namesEqual if that collation has the same case policy
+	const collation = `SELECT collation_name FROM information_schema.columns
+			WHERE table_schema=DATABASE() AND table_name='actions' AND column_name='action_name'`
+	var name string
+	if err := db.QueryRow(collation).Scan(&name); err != nil {
+		return errors.Wrapf(err, "unable to query: %v", collation)
+	}
+	if err := checkNameCollation(name, caseSensitiveNames); err != nil {
+		return err
+	}
+
+	const index = `SELECT COUNT(*) FROM information_schema.statistics
+			WHERE table_schema=DATABASE() AND table_name='actions' AND index_name='actions_user_name'`
+	var n int64
+	if err := db.QueryRow(index).Scan(&n); err != nil {
+		return errors.Wrapf(err, "unable to query: %v", index)
+	}
+	if n == 0 {
+		const create = `CREATE UNIQUE INDEX actions_user_name ON actions(user_id, action_name)`
+		if _, err := db.Exec(create); err != nil {
+			return errors.Wrapf(err, "unable to exec query: %v", create)
+		}
+	}
+
+	return nil
+}
+
+// checkNameCollation returns an error unless the actions.action_name
+// collation treats case as caseSensitiveNames does.
+func checkNameCollation(collation string, caseSensitiveNames bool) error {
+	collation = strings.ToLower(collation)
+	caseSensitive := strings.HasSuffix(collation, "_bin") || strings.HasSuffix(collation, "_cs")
+	if caseSensitive == caseSensitiveNames {
+		return nil
+	}
+	if caseSensitiveNames {
+		return errors.Errorf("actions.action_name uses collation %s which ignores case, case-sensitive names need a binary collation such as utf8_bin: ALTER TABLE actions MODIFY action_name varchar(255) COLLATE utf8_bin", collation)
+	}
+	return errors.Errorf("actions.action_name uses collation %s which compares case, case-insensitive names need a collation such as utf8_general_ci: ALTER TABLE actions MODIFY action_name varchar(255) COLLATE utf8_general_ci", collation)
+}
+
+// addMissingColumns adds each of columns, a name and its definition, that
+// table does not have yet.
+func addMissingColumns(db *sql.DB, table string, columns [][2]string) error {
+	const query = `SELECT column_name FROM information_schema.columns WHERE table_schema=DATABASE() AND table_name=?`
+	rows, err := db.Query(query, table)
+	if err != nil {
+		return errors.Wrapf(err, "unable to query: %v", query)
+	}
+	defer rows.Close()
+	have := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return errors.Wrap(err, "unable to scan column name")
+		}
+		have[strings.ToLower(name)] = true
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrapf(err, "unable to query: %v", query)
+	}
+	rows.Close()
+	if len(have) == 0 {
+		return errors.Errorf("table %s does not exist, create it with envscript/createTables.sql", table)
 	}
-	//if err := d.Ping(); err != nil {
-	//return nil, errors.Wrapf(err, "cannot make initial database connection to %s", conn)
-	//}
 
-	return &Database{d}, nil
+	for _, c := range columns {
+		if have[c[0]] {
+			continue
+		}
+		alter := `ALTER TABLE ` + table + ` ADD COLUMN ` + c[0] + ` ` + c[1]
+		if _, err := db.Exec(alter); err != nil {
+			return errors.Wrapf(err, "unable to exec query: %v", alter)
+		}
+	}
+	return nil
 }
 
 type Database struct {
-	db *sql.DB
+	db                 *sql.DB
+	caseSensitiveNames bool
+}
+
+// Close closes the connection to the database.
+func (d *Database) Close() error {
+	return d.db.Close()
 }
 
+// namesEqual returns a condition comparing the action names a and b under
+// the name case policy of d. Open checks that the actions.action_name
+// collation, which its unique index uses, has the same policy.
+func (d *Database) namesEqual(a, b string) string {
+	if d.caseSensitiveNames {
+		return "BINARY " + a + "=" + b
+	}
+	return "LOWER(" + a + ")=LOWER(" + b + ")"
+}
+
+// CreateAction creates in, returning ErrActionExists if in.UserID already has
+// an action named in.Name.
 func (d *Database) CreateAction(in *pb.Action) (*pb.Action, error) {
-	const query = `INSERT actions SET action_name=?, user_id=?`
-	id, err := exec(d.db, query, in.GetName(), in.GetUserID())
+	exists := `SELECT COUNT(*) FROM actions WHERE user_id=? AND ` + d.namesEqual("action_name", "?")
+	var n int64
+	if err := d.db.QueryRow(exists, in.GetUserID(), in.GetName()).Scan(&n); err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", exists)
+	}
+	if n > 0 {
+		return nil, ErrActionExists
+	}
+
+	reminder, err := encodeReminder(in.GetReminder())
+	if err != nil {
+		return nil, err
+	}
+	const query = `INSERT actions SET action_name=?, user_id=?, monotonic=?, description=?, reminder=?, created_at=?`
+	id, err := exec(d.db, query, in.GetName(), in.GetUserID(), in.GetMonotonic(), in.GetDescription(), reminder, time.Now().UnixNano())
+	if isDuplicateName(err) {
+		// Another request created the action after the check above
+		return nil, ErrActionExists
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -37,8 +197,8 @@ func (d *Database) CreateAction(in *pb.Action) (*pb.Action, error) {
 }
 
 func (d *Database) CreateOccurrence(in *pb.Occurrence) (*pb.Occurrence, error) {
-	const query = `INSERT occurrences SET action_id=?, datetime=?, data=?`
-	id, err := exec(d.db, query, in.GetActionID(), in.GetDatetime(), in.GetData())
+	const query = `INSERT occurrences SET action_id=?, datetime=?, data=?, rating=?, geohash=?`
+	id, err := exec(d.db, query, in.GetActionID(), in.GetDatetime(), in.GetData(), in.GetRating(), in.GetGeohash())
 	if err != nil {
 		return nil, err
 	}
@@ -47,30 +207,652 @@ func (d *Database) CreateOccurrence(in *pb.Occurrence) (*pb.Occurrence, error) {
 	return in, nil
 }
 
+// CreateOccurrenceChecked creates in after passing the existing occurrences
+// of its action to check, in a single transaction so no other occurrence of
+// the action is created in between. If check returns an error nothing is
+// created and that error is returned.
+func (d *Database) CreateOccurrenceChecked(in *pb.Occurrence, check func(existing []*pb.Occurrence) error) (*pb.Occurrence, error) {
+	const lock = `SELECT id FROM actions WHERE id=? FOR UPDATE`
+	const query = `SELECT id, action_id, datetime, data, rating, geohash FROM occurrences WHERE action_id=?`
+	const insert = `INSERT occurrences SET action_id=?, datetime=?, data=?, rating=?, geohash=?`
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to begin transaction")
+	}
+	defer tx.Rollback()
+
+	// Locking the action row makes creates for the same action take turns.
+	// The read below is the first plain read, so it sees what the create
+	// before this one committed
+	var id int64
+	if err := tx.QueryRow(lock, in.GetActionID()).Scan(&id); err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", lock)
+	}
+
+	rows, err := tx.Query(query, in.GetActionID())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", query)
+	}
+	var existing []*pb.Occurrence
+	for rows.Next() {
+		var o pb.Occurrence
+		if err := rows.Scan(&o.ID, &o.ActionID, &o.Datetime, &o.Data, &o.Rating, &o.Geohash); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		existing = append(existing, &o)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := check(existing); err != nil {
+		return nil, err
+	}
+
+	resp, err := tx.Exec(insert, in.GetActionID(), in.GetDatetime(), in.GetData(), in.GetRating(), in.GetGeohash())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to exec query: %v", insert)
+	}
+	if in.ID, err = resp.LastInsertId(); err != nil {
+		return nil, errors.Wrapf(err, "unable to get last id after query: %v", insert)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "unable to commit transaction")
+	}
+
+	return in, nil
+}
+
+// CreateActionWithOccurrence creates action and then occurrence in that
+// action in a single transaction, so the action is never left without the
+// occurrence it was created for. ErrActionExists is returned if the user
+// already has an action with the same name.
+func (d *Database) CreateActionWithOccurrence(action *pb.Action, occurrence *pb.Occurrence) (*pb.Action, *pb.Occurrence, error) {
+	exists := `SELECT COUNT(*) FROM actions WHERE user_id=? AND ` + d.namesEqual("action_name", "?")
+	const insertAction = `INSERT actions SET action_name=?, user_id=?, monotonic=?, description=?, reminder=?, created_at=?`
+	const insertOccurrence = `INSERT occurrences SET action_id=?, datetime=?, data=?, rating=?, geohash=?`
+
+	reminder, err := encodeReminder(action.GetReminder())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "unable to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var n int64
+	if err := tx.QueryRow(exists, action.GetUserID(), action.GetName()).Scan(&n); err != nil {
+		return nil, nil, errors.Wrapf(err, "unable to query: %v", exists)
+	}
+	if n > 0 {
+		return nil, nil, ErrActionExists
+	}
+	resp, err := tx.Exec(insertAction, action.GetName(), action.GetUserID(), action.GetMonotonic(), action.GetDescription(), reminder, time.Now().UnixNano())
+	if isDuplicateName(err) {
+		return nil, nil, ErrActionExists
+	}
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "unable to exec query: %v", insertAction)
+	}
+	if action.ID, err = resp.LastInsertId(); err != nil {
+		return nil, nil, errors.Wrapf(err, "unable to get last id after query: %v", insertAction)
+	}
+	occurrence.ActionID = action.ID
+	resp, err = tx.Exec(insertOccurrence, occurrence.GetActionID(), occurrence.GetDatetime(), occurrence.GetData(), occurrence.GetRating(), occurrence.GetGeohash())
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "unable to exec query: %v", insertOccurrence)
+	}
+	if occurrence.ID, err = resp.LastInsertId(); err != nil {
+		return nil, nil, errors.Wrapf(err, "unable to get last id after query: %v", insertOccurrence)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to commit transaction")
+	}
+	return action, occurrence, nil
+}
+
 func (d *Database) ReadActionByID(id int64) (*pb.Action, error) {
-	const query = `SELECT * FROM actions WHERE id=?`
+	const query = `SELECT id, action_name, user_id, last_touched, monotonic, description, reminder FROM actions WHERE id=?`
 	resp := d.db.QueryRow(query, id)
-	var action pb.Action
-	err := resp.Scan(&action.ID, &action.Name, &action.UserID)
+	action, err := scanAction(resp)
 	if err != nil {
 		return nil, err
 	}
 
-	return &action, nil
+	return action, nil
 }
 
 func (d *Database) ReadActionByNameAndUserID(name string, userID int64) (*pb.Action, error) {
-	const query = `SELECT * FROM actions WHERE action_name=? AND user_id=?`
+	query := `SELECT id, action_name, user_id, last_touched, monotonic, description, reminder FROM actions WHERE ` +
+		d.namesEqual("action_name", "?") + ` AND user_id=?`
 	resp := d.db.QueryRow(query, name, userID)
+	action, err := scanAction(resp)
+	if err == sql.ErrNoRows {
+		return nil, ErrActionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return action, nil
+}
+
+// CloneActions copies the actions of sourceUserID to userID in a single
+// transaction. Actions whose name userID already has, under the name case
+// policy, are skipped. The new actions are returned. ErrActionExists is
+// returned if userID creates one of the names while the copy is running.
+func (d *Database) CloneActions(sourceUserID int64, userID int64) ([]*pb.Action, error) {
+	query := `SELECT s.action_name, s.monotonic, s.description, s.reminder FROM actions s
+			WHERE s.user_id=? AND NOT EXISTS (
+				SELECT 1 FROM actions t WHERE t.user_id=? AND ` + d.namesEqual("t.action_name", "s.action_name") + `)
+			ORDER BY s.id`
+	const insert = `INSERT actions SET action_name=?, user_id=?, monotonic=?, description=?, reminder=?, created_at=?`
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to begin transaction")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(query, sourceUserID, userID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", query)
+	}
+	var sources []*pb.Action
+	var reminders []string
+	for rows.Next() {
+		var source pb.Action
+		var reminder string
+		if err := rows.Scan(&source.Name, &source.Monotonic, &source.Description, &reminder); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		sources = append(sources, &source)
+		reminders = append(reminders, reminder)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var actions []*pb.Action
+	for i, source := range sources {
+		resp, err := tx.Exec(insert, source.GetName(), userID, source.GetMonotonic(), source.GetDescription(), reminders[i], time.Now().UnixNano())
+		if isDuplicateName(err) {
+			return nil, ErrActionExists
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to exec query: %v", insert)
+		}
+		id, err := resp.LastInsertId()
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to get last id after query: %v", insert)
+		}
+		reminder, err := decodeReminder(reminders[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot decode reminder of action %d", id)
+		}
+		actions = append(actions, &pb.Action{
+			ID:          id,
+			Name:        source.GetName(),
+			UserID:      userID,
+			Monotonic:   source.GetMonotonic(),
+			Description: source.GetDescription(),
+			Reminder:    reminder,
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "unable to commit transaction")
+	}
+
+	return actions, nil
+}
+
+// TouchAction sets the last_touched time of the action with the passed id to
+// at, in unix nanoseconds.
+func (d *Database) TouchAction(id int64, at int64) error {
+	const query = `UPDATE actions SET last_touched=? WHERE id=?`
+	_, err := d.db.Exec(query, at, id)
+	if err != nil {
+		return errors.Wrapf(err, "unable to exec query: %v", query)
+	}
+
+	return nil
+}
+
+// TransferAction makes userID the owner of the action with the passed id if
+// fromUserID owns it, checking both in the same transaction as the move. Its
+// occurrences belong to the action and move with it. ErrActionNotOwned is
+// returned if fromUserID does not own the action, and ErrActionExists if
+// userID already has an action with the same name.
+func (d *Database) TransferAction(id int64, fromUserID int64, userID int64) error {
+	exists := `SELECT COUNT(*) FROM actions s JOIN actions t ON ` + d.namesEqual("t.action_name", "s.action_name") + `
+			WHERE s.id=? AND t.user_id=?`
+	const query = `UPDATE actions SET user_id=? WHERE id=? AND user_id=?`
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "unable to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var n int64
+	if err := tx.QueryRow(exists, id, userID).Scan(&n); err != nil {
+		return errors.Wrapf(err, "unable to query: %v", exists)
+	}
+	if n > 0 {
+		return ErrActionExists
+	}
+	resp, err := tx.Exec(query, userID, id, fromUserID)
+	if isDuplicateName(err) {
+		return ErrActionExists
+	}
+	if err != nil {
+		return errors.Wrapf(err, "unable to exec query: %v", query)
+	}
+	n, err = resp.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "unable to get rows affected by query: %v", query)
+	}
+	if n == 0 {
+		return ErrActionNotOwned
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "unable to commit transaction")
+	}
+	return nil
+}
+
+// ReadActions returns all actions owned by userID that have at least
+// minOccurrences occurrences. A minOccurrences of zero returns every action.
+// If byRecency is true the most recently touched actions are returned first.
+func (d *Database) ReadActions(userID int64, minOccurrences int64, byRecency bool) ([]*pb.Action, error) {
+	query := `SELECT a.id, a.action_name, a.user_id, a.last_touched, a.monotonic, a.description, a.reminder FROM actions a
+			LEFT JOIN occurrences o ON o.action_id=a.id
+			WHERE a.user_id=?
+			GROUP BY a.id, a.action_name, a.user_id, a.last_touched, a.monotonic, a.description, a.reminder
+			HAVING COUNT(o.id)>=?`
+	if byRecency {
+		query += ` ORDER BY a.last_touched DESC, a.id`
+	}
+	rows, err := d.db.Query(query, userID, minOccurrences)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", query)
+	}
+	defer rows.Close()
+
+	var actions []*pb.Action
+	for rows.Next() {
+		action, err := scanAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+// ReadUnloggedActions returns the actions owned by userID that have no
+// occurrences, oldest first. If createdBefore, in unix nanoseconds, is not
+// zero only actions created before then are returned. Actions created before
+// created_at was added count as created at zero.
+func (d *Database) ReadUnloggedActions(userID int64, createdBefore int64) ([]*pb.Action, error) {
+	const query = `SELECT a.id, a.action_name, a.user_id, a.last_touched, a.monotonic, a.description, a.reminder FROM actions a
+			LEFT JOIN occurrences o ON o.action_id=a.id
+			WHERE a.user_id=? AND o.id IS NULL AND (?=0 OR a.created_at<?)
+			ORDER BY a.id`
+	rows, err := d.db.Query(query, userID, createdBefore, createdBefore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", query)
+	}
+	defer rows.Close()
+
+	var actions []*pb.Action
+	for rows.Next() {
+		action, err := scanAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+// ReadUserUsage returns how many actions and occurrences userID has and the
+// total length in bytes of their occurrence data.
+func (d *Database) ReadUserUsage(userID int64) (actions, occurrences, dataBytes int64, err error) {
+	const actionsQuery = `SELECT COUNT(*) FROM actions WHERE user_id=?`
+	const occurrencesQuery = `SELECT COUNT(o.id), COALESCE(SUM(LENGTH(o.data)), 0)
+			FROM occurrences o JOIN actions a ON o.action_id=a.id
+			WHERE a.user_id=?`
+
+	if err := d.db.QueryRow(actionsQuery, userID).Scan(&actions); err != nil {
+		return 0, 0, 0, errors.Wrapf(err, "unable to query: %v", actionsQuery)
+	}
+	if err := d.db.QueryRow(occurrencesQuery, userID).Scan(&occurrences, &dataBytes); err != nil {
+		return 0, 0, 0, errors.Wrapf(err, "unable to query: %v", occurrencesQuery)
+	}
+
+	return actions, occurrences, dataBytes, nil
+}
+
+// ReadOccurrenceIDsByActionID returns the IDs of every occurrence of the
+// action with the passed id.
+func (d *Database) ReadOccurrenceIDsByActionID(actionID int64) ([]int64, error) {
+	const query = `SELECT id FROM occurrences WHERE action_id=?`
+	rows, err := d.db.Query(query, actionID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", query)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ReadOccurrencesByActionID returns every occurrence of the action with the
+// passed id.
+func (d *Database) ReadOccurrencesByActionID(actionID int64) ([]*pb.Occurrence, error) {
+	const query = `SELECT id, action_id, datetime, data, rating, geohash FROM occurrences WHERE action_id=?`
+	rows, err := d.db.Query(query, actionID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", query)
+	}
+	defer rows.Close()
+
+	var occurrences []*pb.Occurrence
+	for rows.Next() {
+		var o pb.Occurrence
+		err := rows.Scan(&o.ID, &o.ActionID, &o.Datetime, &o.Data, &o.Rating, &o.Geohash)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, &o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return occurrences, nil
+}
+
+// ReadOccurrencesByUserID returns every occurrence of every action owned by
+// userID.
+func (d *Database) ReadOccurrencesByUserID(userID int64) ([]*pb.Occurrence, error) {
+	const query = `SELECT o.id, o.action_id, o.datetime, o.data, o.rating, o.geohash
+			FROM occurrences o JOIN actions a ON o.action_id=a.id
+			WHERE a.user_id=?`
+	rows, err := d.db.Query(query, userID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", query)
+	}
+	defer rows.Close()
+
+	var occurrences []*pb.Occurrence
+	for rows.Next() {
+		var o pb.Occurrence
+		err := rows.Scan(&o.ID, &o.ActionID, &o.Datetime, &o.Data, &o.Rating, &o.Geohash)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, &o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return occurrences, nil
+}
+
+// ReadActionsByIDs returns the actions with the passed ids, in no particular
+// order. Ids without an action are left out.
+func (d *Database) ReadActionsByIDs(ids []int64) ([]*pb.Action, error) {
+	query := `SELECT id, action_name, user_id, last_touched, monotonic, description, reminder FROM actions
+			WHERE id IN (` + placeholders(len(ids)) + `)`
+	rows, err := d.db.Query(query, idArgs(ids)...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", query)
+	}
+	defer rows.Close()
+
+	var actions []*pb.Action
+	for rows.Next() {
+		action, err := scanAction(rows)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+// ReadOccurrencesByActionIDs returns every occurrence of the actions with the
+// passed ids.
+func (d *Database) ReadOccurrencesByActionIDs(ids []int64) ([]*pb.Occurrence, error) {
+	query := `SELECT id, action_id, datetime, data, rating, geohash FROM occurrences
+			WHERE action_id IN (` + placeholders(len(ids)) + `)`
+	rows, err := d.db.Query(query, idArgs(ids)...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query: %v", query)
+	}
+	defer rows.Close()
+
+	var occurrences []*pb.Occurrence
+	for rows.Next() {
+		var o pb.Occurrence
+		err := rows.Scan(&o.ID, &o.ActionID, &o.Datetime, &o.Data, &o.Rating, &o.Geohash)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, &o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return occurrences, nil
+}
+
+// ShiftOccurrenceDatetimes passes the occurrences of the action with actionID,
+// or of every action of userID if actionID is 0, to shift and sets the
+// datetime of each occurrence in the map it returns. Reading and updating
+// happen in a single transaction. Only actions userID owns are read, and if
+// shift returns an error nothing is updated and that error is returned.
+func (d *Database) ShiftOccurrenceDatetimes(userID int64, actionID int64, shift func(occurrences []*pb.Occurrence) (map[int64]string, error)) error {
+	query := `SELECT o.id, o.action_id, o.datetime, o.data, o.rating, o.geohash
+			FROM occurrences o JOIN actions a ON o.action_id=a.id
+			WHERE a.user_id=?`
+	args := []interface{}{userID}
+	if actionID != 0 {
+		query += ` AND a.id=?`
+		args = append(args, actionID)
+	}
+	query += ` FOR UPDATE`
+	const update = `UPDATE occurrences SET datetime=? WHERE id=?`
+
+	// FOR UPDATE reads the latest datetimes and holds them until commit
+	tx, err := d.db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "unable to begin transaction")
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "unable to query: %v", query)
+	}
+	var occurrences []*pb.Occurrence
+	for rows.Next() {
+		var o pb.Occurrence
+		if err := rows.Scan(&o.ID, &o.ActionID, &o.Datetime, &o.Data, &o.Rating, &o.Geohash); err != nil {
+			rows.Close()
+			return err
+		}
+		occurrences = append(occurrences, &o)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	datetimes, err := shift(occurrences)
+	if err != nil {
+		return err
+	}
+	for id, datetime := range datetimes {
+		if _, err := tx.Exec(update, datetime, id); err != nil {
+			return errors.Wrapf(err, "unable to exec query: %v", update)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "unable to commit transaction")
+	}
+	return nil
+}
+
+// MergeOccurrences updates the data and rating of each occurrence in kept and
+// deletes the occurrences with the passed ids in a single transaction.
+func (d *Database) MergeOccurrences(kept []*pb.Occurrence, deleted []int64) error {
+	const update = `UPDATE occurrences SET data=?, rating=? WHERE id=?`
+	const query = `DELETE FROM occurrences WHERE id=?`
+
+	tx, err := d.db.Begin()
+	if err != nil {
+		return errors.Wrap(err, "unable to begin transaction")
+	}
+	defer tx.Rollback()
+
+	for _, o := range kept {
+		if _, err := tx.Exec(update, o.GetData(), o.GetRating(), o.GetID()); err != nil {
+			return errors.Wrapf(err, "unable to exec query: %v", update)
+		}
+	}
+	for _, id := range deleted {
+		if _, err := tx.Exec(query, id); err != nil {
+			return errors.Wrapf(err, "unable to exec query: %v", query)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "unable to commit transaction")
+	}
+	return nil
+}
+
+// scanAction scans a row of the action columns id, action_name, user_id,
+// last_touched, monotonic, description and reminder.
+func scanAction(row interface {
+	Scan(dest ...interface{}) error
+}) (*pb.Action, error) {
 	var action pb.Action
-	err := resp.Scan(&action.ID, &action.Name, &action.UserID)
+	var reminder string
+	err := row.Scan(&action.ID, &action.Name, &action.UserID, &action.LastTouched, &action.Monotonic, &action.Description, &reminder)
 	if err != nil {
 		return nil, err
 	}
+	action.Reminder, err = decodeReminder(reminder)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot decode reminder of action %d", action.ID)
+	}
 
 	return &action, nil
 }
 
+// maxReminderLength is the size of actions.reminder.
+const maxReminderLength = 1024
+
+// encodeReminder returns r as it is stored in actions.reminder. A reminder
+// without times is stored as the empty string.
+func encodeReminder(r *pb.Reminder) (string, error) {
+	if len(r.GetTimes()) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "", errors.Wrap(err, "cannot encode reminder")
+	}
+	// A truncated reminder could not be decoded again
+	if len(b) > maxReminderLength {
+		return "", errors.Errorf("encoded reminder is %d characters, at most %d fit", len(b), maxReminderLength)
+	}
+	return string(b), nil
+}
+
+// decodeReminder is the inverse of encodeReminder.
+func decodeReminder(s string) (*pb.Reminder, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var r pb.Reminder
+	if err := json.Unmarshal([]byte(s), &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// isDuplicateName reports whether err is MySQL refusing an insert or update
+// because of the unique action name index.
+func isDuplicateName(err error) bool {
+	e, ok := errors.Cause(err).(*mysqldriver.MySQLError)
+	// ER_DUP_ENTRY
+	return ok && e.Number == 1062
+}
+
+// placeholders returns n comma separated query placeholders for an IN list.
+// At least one is returned so that an empty list is still valid SQL.
+func placeholders(n int) string {
+	if n < 1 {
+		n = 1
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// idArgs returns ids as query arguments.
+func idArgs(ids []int64) []interface{} {
+	if len(ids) == 0 {
+		// Matches no row, as ids start at 1
+		return []interface{}{0}
+	}
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	return args
+}
+
 // exec calls db.db.Exec with passed arguments and returns the id of the LastInsertId
 func exec(db *sql.DB, query string, args ...interface{}) (int64, error) {
 	resp, err := db.Exec(query, args...)
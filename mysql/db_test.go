@@ -0,0 +1,129 @@
+package mysql
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+
+	pb "github.com/adamryman/ambition-model/ambition-service"
+)
+
+// rawTestDB connects to MYSQL_TEST_DSN, such as
+// "root:ambition@tcp(localhost:3306)/ambition_test", and replaces its actions
+// and occurrences tables with the ones statements create. The database must
+// be one the tests may drop tables in. Tests needing it are skipped when
+// MYSQL_TEST_DSN is unset.
+func rawTestDB(t *testing.T, statements ...string) (string, *sql.DB) {
+	conn := os.Getenv("MYSQL_TEST_DSN")
+	if conn == "" {
+		t.Skip("MYSQL_TEST_DSN is unset")
+	}
+	db, err := sql.Open("mysql", conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	drop := []string{`DROP TABLE IF EXISTS actions`, `DROP TABLE IF EXISTS occurrences`}
+	for _, query := range append(drop, statements...) {
+		if _, err := db.Exec(query); err != nil {
+			db.Close()
+			t.Fatal(err)
+		}
+	}
+	return conn, db
+}
+
+func TestOpenAddsMissingColumns(t *testing.T) {
+	conn, old := rawTestDB(t,
+		`CREATE TABLE actions(id SERIAL PRIMARY KEY, action_name varchar(255), set_id integer, user_id integer, trello_id varchar(255))`,
+		`CREATE TABLE occurrences(id SERIAL PRIMARY KEY, action_id integer, datetime varchar(255), data varchar(255))`,
+		`INSERT actions SET action_name='Run', user_id=1`,
+		`INSERT occurrences SET action_id=1, datetime='2016-10-01 07:00:00 -0700 PDT', data='5k'`,
+	)
+	defer old.Close()
+
+	d, err := Open(conn, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	a, err := d.ReadActionByID(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.GetName() != "Run" || a.GetDescription() != "" || a.GetMonotonic() {
+		t.Errorf("existing action read as %v", a)
+	}
+	occurrences, err := d.ReadOccurrencesByActionID(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occurrences) != 1 || occurrences[0].GetData() != "5k" || occurrences[0].GetRating() != 0 {
+		t.Errorf("existing occurrences read as %v", occurrences)
+	}
+	if _, err := d.CreateOccurrence(&pb.Occurrence{ActionID: 1, Rating: 4, Geohash: "9q8yy"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Opening again finds every column present
+	again, err := Open(conn, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again.Close()
+}
+
+func TestCheckNameCollation(t *testing.T) {
+	cases := []struct {
+		collation          string
+		caseSensitiveNames bool
+		ok                 bool
+	}{
+		{"utf8_general_ci", false, true},
+		{"utf8mb4_0900_ai_ci", false, true},
+		{"utf8_bin", false, false},
+		{"utf8mb4_0900_as_cs", false, false},
+		{"utf8_bin", true, true},
+		{"utf8mb4_bin", true, true},
+		{"utf8mb4_0900_as_cs", true, true},
+		{"utf8_general_ci", true, false},
+		{"latin1_swedish_ci", true, false},
+	}
+	for _, c := range cases {
+		err := checkNameCollation(c.collation, c.caseSensitiveNames)
+		if (err == nil) != c.ok {
+			t.Errorf("%s with case-sensitive names %t: got error %v, want ok %t", c.collation, c.caseSensitiveNames, err, c.ok)
+		}
+	}
+}
+
+func TestCaseSensitiveNamesCollation(t *testing.T) {
+	conn, raw := rawTestDB(t,
+		`CREATE TABLE actions(id SERIAL PRIMARY KEY, action_name varchar(255), set_id integer, user_id integer, trello_id varchar(255))`,
+		`CREATE TABLE occurrences(id SERIAL PRIMARY KEY, action_id integer, datetime varchar(255), data varchar(255))`,
+	)
+	defer raw.Close()
+
+	if d, err := Open(conn, true); err == nil {
+		d.Close()
+		t.Fatal("opened with case-sensitive names on a case-insensitive collation")
+	}
+
+	if _, err := raw.Exec(`ALTER TABLE actions MODIFY action_name varchar(255) COLLATE utf8_bin`); err != nil {
+		t.Fatal(err)
+	}
+	d, err := Open(conn, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	if _, err := d.CreateAction(&pb.Action{Name: "Yoga", UserID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.CreateAction(&pb.Action{Name: "yoga", UserID: 1}); err != nil {
+		t.Errorf("creating yoga after Yoga: %v", err)
+	}
+	if _, err := d.CreateAction(&pb.Action{Name: "yoga", UserID: 1}); err != ErrActionExists {
+		t.Errorf("creating yoga twice: got %v, want ErrActionExists", err)
+	}
+}
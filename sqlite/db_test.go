@@ -0,0 +1,521 @@
+package mysql
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/adamryman/ambition-model/ambition-service"
+)
+
+// openTestDB opens a new sqlite database file, returning it and a func that
+// removes it.
+func openTestDB(t *testing.T, caseSensitiveNames bool) (*Database, func()) {
+	dir, err := ioutil.TempDir("", "ambition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := Open(filepath.Join(dir, "ambition.db"), caseSensitiveNames)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return d, func() {
+		d.db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestCreateActionNameCase(t *testing.T) {
+	cases := []struct {
+		caseSensitiveNames bool
+		name               string
+		want               error
+	}{
+		{false, "Yoga", ErrActionExists},
+		{false, "yoga", ErrActionExists},
+		{false, "YOGA", ErrActionExists},
+		{false, "Yoga nidra", nil},
+		{true, "Yoga", ErrActionExists},
+		{true, "yoga", nil},
+		{true, "YOGA", nil},
+	}
+	for _, c := range cases {
+		d, cleanup := openTestDB(t, c.caseSensitiveNames)
+		if _, err := d.CreateAction(&pb.Action{Name: "Yoga", UserID: 1}); err != nil {
+			t.Fatal(err)
+		}
+		_, err := d.CreateAction(&pb.Action{Name: c.name, UserID: 1})
+		if err != c.want {
+			t.Errorf("case sensitive %t: creating %q after \"Yoga\": got error %v, want %v", c.caseSensitiveNames, c.name, err, c.want)
+		}
+		if _, err := d.CreateAction(&pb.Action{Name: c.name, UserID: 2}); err != nil {
+			t.Errorf("case sensitive %t: creating %q for another user: unexpected error %v", c.caseSensitiveNames, c.name, err)
+		}
+		cleanup()
+	}
+}
+
+func TestCreateActionConcurrent(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	const n = 8
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := d.CreateAction(&pb.Action{Name: "Run", UserID: 1})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	created := 0
+	for err := range errs {
+		switch err {
+		case nil:
+			created++
+		case ErrActionExists:
+		default:
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if created != 1 {
+		t.Errorf("%d concurrent creates of the same name succeeded, want 1", created)
+	}
+}
+
+func TestDuplicateNameFromIndex(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	// Skip the check in CreateAction to hit the unique index directly
+	const query = `INSERT INTO actions(action_name, user_id) VALUES (?, ?)`
+	if _, err := exec(d.db, query, "Run", 1); err != nil {
+		t.Fatal(err)
+	}
+	_, err := exec(d.db, query, "run", 1)
+	if !isDuplicateName(err) {
+		t.Errorf("duplicate insert: got error %v, want a duplicate name error", err)
+	}
+}
+
+func TestTransferActionNameConflict(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	a, err := d.CreateAction(&pb.Action{Name: "Run", UserID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.CreateAction(&pb.Action{Name: "run", UserID: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.TransferAction(a.GetID(), 1, 2); err != ErrActionExists {
+		t.Errorf("transfer to a user with the name: got error %v, want %v", err, ErrActionExists)
+	}
+	if err := d.TransferAction(a.GetID(), 1, 3); err != nil {
+		t.Errorf("transfer to a user without the name: unexpected error %v", err)
+	}
+}
+
+func TestTransferActionConcurrent(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	a, err := d.CreateAction(&pb.Action{Name: "Run", UserID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each transfers from user 1, only the first to run still finds it there
+	const n = 8
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(to int64) {
+			defer wg.Done()
+			errs <- d.TransferAction(a.GetID(), 1, to)
+		}(int64(i + 2))
+	}
+	wg.Wait()
+	close(errs)
+
+	moved := 0
+	for err := range errs {
+		switch err {
+		case nil:
+			moved++
+		case ErrActionNotOwned:
+		default:
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if moved != 1 {
+		t.Errorf("%d concurrent transfers succeeded, want 1", moved)
+	}
+}
+
+func TestCreateActionWithOccurrenceConcurrent(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	const n = 8
+	errs := make(chan error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, err := d.CreateActionWithOccurrence(&pb.Action{Name: "Run", UserID: 1}, &pb.Occurrence{Datetime: "2016-10-01T08:00:00Z"})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	created := 0
+	for err := range errs {
+		switch err {
+		case nil:
+			created++
+		case ErrActionExists:
+		default:
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if created != 1 {
+		t.Errorf("%d concurrent auto creates of the same name succeeded, want 1", created)
+	}
+
+	a, err := d.ReadActionByNameAndUserID("run", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	occurrences, err := d.ReadOccurrencesByActionID(a.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occurrences) != 1 {
+		t.Errorf("action has %d occurrences, want only the one it was created with", len(occurrences))
+	}
+}
+
+func TestReadActionByNameAndUserIDNotFound(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	if _, err := d.ReadActionByNameAndUserID("Run", 1); err != ErrActionNotFound {
+		t.Errorf("got error %v, want %v", err, ErrActionNotFound)
+	}
+}
+
+func TestEncodeReminderTooLong(t *testing.T) {
+	r := &pb.Reminder{Timezone: "UTC"}
+	for i := 0; i < 200; i++ {
+		r.Times = append(r.Times, "08:00")
+	}
+	if s, err := encodeReminder(r); err == nil {
+		t.Errorf("reminder encoded to %d characters was not rejected", len(s))
+	}
+}
+
+func TestReadUserUsage(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	seed := map[int64]map[string][]string{
+		1: {
+			"Run":  {"5k", "10k", ""},
+			"Read": {"café"},
+			"Yoga": nil,
+		},
+		2: {
+			"Run": {"marathon"},
+		},
+	}
+	for userID, actions := range seed {
+		for name, data := range actions {
+			a, err := d.CreateAction(&pb.Action{Name: name, UserID: userID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, v := range data {
+				if _, err := d.CreateOccurrence(&pb.Occurrence{ActionID: a.GetID(), Data: v}); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+
+	cases := []struct {
+		userID                          int64
+		actions, occurrences, dataBytes int64
+	}{
+		// "café" is 5 bytes
+		{1, 3, 4, 2 + 3 + 5},
+		{2, 1, 1, 8},
+		{3, 0, 0, 0},
+	}
+	for _, c := range cases {
+		actions, occurrences, dataBytes, err := d.ReadUserUsage(c.userID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actions != c.actions || occurrences != c.occurrences || dataBytes != c.dataBytes {
+			t.Errorf("user %d: got %d actions, %d occurrences, %d bytes, want %d, %d, %d",
+				c.userID, actions, occurrences, dataBytes, c.actions, c.occurrences, c.dataBytes)
+		}
+	}
+}
+
+func TestReadOccurrencesByActionIDs(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	var ids []int64
+	for i, name := range []string{"Run", "Read", "Yoga"} {
+		a, err := d.CreateAction(&pb.Action{Name: name, UserID: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, a.GetID())
+		for j := 0; j <= i; j++ {
+			if _, err := d.CreateOccurrence(&pb.Occurrence{ActionID: a.GetID()}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	actions, err := d.ReadActionsByIDs([]int64{ids[0], ids[2], 999})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actions) != 2 {
+		t.Errorf("got %d actions, want 2", len(actions))
+	}
+
+	occurrences, err := d.ReadOccurrencesByActionIDs([]int64{ids[1], ids[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := make(map[int64]int)
+	for _, o := range occurrences {
+		counts[o.GetActionID()]++
+	}
+	if counts[ids[0]] != 0 || counts[ids[1]] != 2 || counts[ids[2]] != 3 {
+		t.Errorf("got occurrence counts by action %v, want none for %d, 2 for %d and 3 for %d", counts, ids[0], ids[1], ids[2])
+	}
+
+	if occurrences, err := d.ReadOccurrencesByActionIDs(nil); err != nil || len(occurrences) != 0 {
+		t.Errorf("no ids: got %d occurrences, %v", len(occurrences), err)
+	}
+}
+
+func TestMergeOccurrences(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	a, err := d.CreateAction(&pb.Action{Name: "Run", UserID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []int64
+	for _, data := range []string{"5k", "10k", "rest"} {
+		o, err := d.CreateOccurrence(&pb.Occurrence{ActionID: a.GetID(), Data: data})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, o.GetID())
+	}
+
+	kept := &pb.Occurrence{ID: ids[0], Data: "5k\n10k", Rating: 4}
+	if err := d.MergeOccurrences([]*pb.Occurrence{kept}, ids[1:2]); err != nil {
+		t.Fatal(err)
+	}
+	occurrences, err := d.ReadOccurrencesByActionID(a.GetID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occurrences) != 2 {
+		t.Fatalf("got %d occurrences after merging, want 2", len(occurrences))
+	}
+	for _, o := range occurrences {
+		switch o.GetID() {
+		case ids[0]:
+			if o.GetData() != kept.GetData() || o.GetRating() != kept.GetRating() {
+				t.Errorf("kept occurrence is %v, want data %q and rating %d", o, kept.GetData(), kept.GetRating())
+			}
+		case ids[2]:
+		default:
+			t.Errorf("occurrence %d was not deleted", o.GetID())
+		}
+	}
+}
+
+func TestOpenAddsMissingColumns(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ambition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ambition.db")
+
+	old, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, query := range []string{
+		`CREATE TABLE actions(id INTEGER PRIMARY KEY AUTOINCREMENT, action_name varchar(255), user_id integer)`,
+		`CREATE TABLE occurrences(id INTEGER PRIMARY KEY AUTOINCREMENT, action_id varchar(255), datetime varchar(255), data varchar(255))`,
+		`INSERT INTO actions(action_name, user_id) VALUES ('Run', 1)`,
+		`INSERT INTO occurrences(action_id, datetime, data) VALUES (1, '2016-10-01 07:00:00-0700', '5k')`,
+	} {
+		if _, err := old.Exec(query); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old.Close()
+
+	d, err := Open(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.db.Close()
+
+	a, err := d.ReadActionByID(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.GetName() != "Run" || a.GetDescription() != "" || a.GetMonotonic() {
+		t.Errorf("existing action read as %v", a)
+	}
+	occurrences, err := d.ReadOccurrencesByActionID(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(occurrences) != 1 || occurrences[0].GetData() != "5k" || occurrences[0].GetRating() != 0 {
+		t.Errorf("existing occurrences read as %v", occurrences)
+	}
+
+	if _, err := d.CreateAction(&pb.Action{Name: "Swim", UserID: 1, Description: "laps"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.CreateOccurrence(&pb.Occurrence{ActionID: 1, Rating: 4, Geohash: "9q8yy"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Opening again finds every column present
+	again, err := Open(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again.db.Close()
+}
+
+func TestReadUnloggedActions(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	now := time.Now()
+	for _, a := range []struct {
+		name    string
+		userID  int64
+		created int64
+		logged  bool
+	}{
+		{"Run", 1, now.AddDate(0, 0, -30).UnixNano(), true},
+		{"Read", 1, now.AddDate(0, 0, -10).UnixNano(), false},
+		{"Yoga", 1, now.UnixNano(), false},
+		// Created before creation times were recorded
+		{"Swim", 1, 0, false},
+		{"Walk", 2, now.AddDate(0, 0, -10).UnixNano(), false},
+	} {
+		action, err := d.CreateAction(&pb.Action{Name: a.name, UserID: a.userID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := d.db.Exec(`UPDATE actions SET created_at=? WHERE id=?`, a.created, action.GetID()); err != nil {
+			t.Fatal(err)
+		}
+		if a.logged {
+			if _, err := d.CreateOccurrence(&pb.Occurrence{ActionID: action.GetID()}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	cases := []struct {
+		createdBefore int64
+		want          []string
+	}{
+		{0, []string{"Read", "Yoga", "Swim"}},
+		{now.AddDate(0, 0, -5).UnixNano(), []string{"Read", "Swim"}},
+		{now.AddDate(0, 0, -20).UnixNano(), []string{"Swim"}},
+		{1, []string{"Swim"}},
+	}
+	for _, c := range cases {
+		actions, err := d.ReadUnloggedActions(1, c.createdBefore)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, a := range actions {
+			got = append(got, a.GetName())
+		}
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("created before %d: got %v, want %v", c.createdBefore, got, c.want)
+		}
+	}
+}
+
+func TestCreateActionSetsCreatedAt(t *testing.T) {
+	d, cleanup := openTestDB(t, false)
+	defer cleanup()
+
+	before := time.Now().UnixNano()
+	if _, err := d.CreateAction(&pb.Action{Name: "Run", UserID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := d.CreateActionWithOccurrence(&pb.Action{Name: "Read", UserID: 1}, &pb.Occurrence{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d.CloneActions(1, 2); err != nil {
+		t.Fatal(err)
+	}
+	after := time.Now().UnixNano()
+
+	rows, err := d.db.Query(`SELECT id, created_at FROM actions`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	n := 0
+	for rows.Next() {
+		var id, createdAt int64
+		if err := rows.Scan(&id, &createdAt); err != nil {
+			t.Fatal(err)
+		}
+		if createdAt < before || createdAt > after {
+			t.Errorf("action %d created at %d, want between %d and %d", id, createdAt, before, after)
+		}
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	// Run and Read, and their clones
+	if n != 4 {
+		t.Errorf("got %d actions, want 4", n)
+	}
+}